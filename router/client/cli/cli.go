@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
@@ -20,6 +22,11 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetInFlightQueryCmd(),
+		GetCmdPaused(),
+		GetCmdChainRoutes(),
+		GetCmdChainRoute(),
+		GetCmdEstimateForward(),
 	)
 
 	return queryCmd
@@ -53,7 +60,503 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdPaused returns the command handler for querying the scopes forwarding is paused for.
+func GetCmdPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused",
+		Short:   "Query the scopes forwarding is paused for",
+		Long:    "Query the scopes forwarding is paused for by the module authority",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-router paused", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Paused(cmd.Context(), &types.QueryPausedRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChainRoutes returns the command handler for querying all routes of the chain registry.
+func GetCmdChainRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "chain-routes",
+		Short:   "Query all routes of the chain registry",
+		Long:    "Query all routes of the chain registry, which forward metadata can target by chain ID or alias",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-router chain-routes", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChainRoutes(cmd.Context(), &types.QueryChainRoutesRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChainRoute returns the command handler for querying a route of the chain registry.
+func GetCmdChainRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-route [chain-id-or-alias]",
+		Short: "Query the route of the chain registry for a chain",
+		Long: `Query the route of the chain registry for a chain ID or alias, or with --bech32-prefix for the chain
+with the given bech32 prefix`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-router chain-route osmosis-1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			byBech32Prefix, err := cmd.Flags().GetBool(flagBech32Prefix)
+			if err != nil {
+				return err
+			}
+
+			var res *types.QueryChainRouteResponse
+			if byBech32Prefix {
+				res, err = queryClient.ChainRouteByBech32Prefix(cmd.Context(), &types.QueryChainRouteByBech32PrefixRequest{
+					Bech32Prefix: args[0],
+				})
+			} else {
+				res, err = queryClient.ChainRoute(cmd.Context(), &types.QueryChainRouteRequest{Chain: args[0]})
+			}
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.ChainRoute)
+		},
+	}
+
+	cmd.Flags().Bool(flagBech32Prefix, false, "Query the chain of a bech32 prefix instead of a chain ID or alias")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateForward returns the command handler for previewing the forward of a received transfer.
+func GetCmdEstimateForward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-forward [src-port] [src-channel] [denom] [amount] [memo]",
+		Short: "Preview the forward of a transfer received by this chain",
+		Long: `Preview the forward of a transfer sent to this chain over the source port and channel of the sending chain,
+with the denom, amount and memo of the packet. Returns the denom on this chain, the fee and net amount of each
+forward, the denom on the next chain and any validation or policy errors. The sender and receiver of the packet
+are optional, and used to estimate the receiver on this chain.`,
+		Args: cobra.ExactArgs(5),
+		Example: fmt.Sprintf(
+			`%s query ibc-router estimate-forward transfer channel-0 uatom 1000 '{"forward":{"receiver":"osmo1...","chain":"osmosis-1"}}'`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return err
+			}
+			receiver, err := cmd.Flags().GetString(flagReceiver)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateForward(cmd.Context(), &types.QueryEstimateForwardRequest{
+				PacketSrcPort:    args[0],
+				PacketSrcChannel: args[1],
+				Denom:            args[2],
+				Amount:           args[3],
+				Memo:             args[4],
+				Sender:           sender,
+				Receiver:         receiver,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "Sender of the packet, from which the receiver on this chain may be derived")
+	cmd.Flags().String(flagReceiver, "", "Receiver of the packet on this chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetInFlightQueryCmd returns the query commands for in-flight forwarded packets
+func GetInFlightQueryCmd() *cobra.Command {
+	inFlightCmd := &cobra.Command{
+		Use:                        "in-flight",
+		Short:                      "Querying commands for packets currently being forwarded",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	inFlightCmd.AddCommand(
+		GetCmdInFlightPackets(),
+		GetCmdInFlightPacket(),
+		GetCmdInFlightPacketsByOriginalSender(),
+	)
+
+	return inFlightCmd
+}
+
+// GetCmdInFlightPackets returns the command handler for querying all in-flight packets.
+func GetCmdInFlightPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Query all packets currently being forwarded",
+		Long:    "Query all packets currently being forwarded that are awaiting an acknowledgement or timeout from the next hop",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-router in-flight list", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InFlightPackets(cmd.Context(), &types.QueryInFlightPacketsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight packets")
+
+	return cmd
+}
+
+// GetCmdInFlightPacket returns the command handler for querying a single in-flight packet.
+func GetCmdInFlightPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet [channel-id] [port-id] [sequence]",
+		Short:   "Query an in-flight packet by the identifiers of the forwarded packet",
+		Long:    "Query an in-flight packet by the source channel, source port and sequence of the packet forwarded to the next hop",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-router in-flight packet channel-0 transfer 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			res, err := queryClient.InFlightPacket(cmd.Context(), &types.QueryInFlightPacketRequest{
+				ChannelId: args[0],
+				PortId:    args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.InFlightPacket)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInFlightPacketsByOriginalSender returns the command handler for querying in-flight packets by original sender.
+func GetCmdInFlightPacketsByOriginalSender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "by-sender [original-sender-address]",
+		Short:   "Query all in-flight packets initiated by a sender",
+		Long:    "Query all in-flight packets initiated by the given sender address on the chain where the transfer originated",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-router in-flight by-sender cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InFlightPacketsByOriginalSender(cmd.Context(), &types.QueryInFlightPacketsByOriginalSenderRequest{
+				OriginalSenderAddress: args[0],
+				Pagination:            pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight packets")
+
+	return cmd
+}
+
 // NewTxCmd returns the transaction commands for router
 func NewTxCmd() *cobra.Command {
-	return nil
+	txCmd := &cobra.Command{
+		Use:                        "ibc-router",
+		Short:                      "ibc-router transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewForceRefundCmd(),
+		NewSetPausedCmd(),
+		NewSetChainRouteCmd(),
+		NewRemoveChainRouteCmd(),
+	)
+
+	return txCmd
+}
+
+// NewForceRefundCmd returns the command to refund an in-flight packet that is stuck on the next hop.
+// The sender must be the module authority, so this is usually generated with --generate-only and
+// submitted as part of a governance proposal.
+func NewForceRefundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-refund [channel-id] [port-id] [sequence]",
+		Short: "Refund an in-flight packet that can no longer be acknowledged or timed out",
+		Long: `Refund an in-flight packet identified by the source channel, source port and sequence of the packet
+forwarded to the next hop. An error acknowledgement is written back to the chain the packet came from.
+The forwarded packet must no longer be deliverable: its channel must be closed or it must have timed out.
+Packets forwarded before the forwarded packet was recorded require --packet-data, --timeout-height and
+--timeout-timestamp, as found in the send_packet event of the forwarded packet.
+The sender must be the module authority.`,
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s tx ibc-router force-refund channel-0 transfer 1 --from authority --generate-only", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			msg := types.NewMsgForceRefund(clientCtx.GetFromAddress().String(), args[0], args[1], sequence)
+			packetData, err := cmd.Flags().GetString(flagPacketData)
+			if err != nil {
+				return err
+			}
+			msg.PacketData = []byte(packetData)
+			if msg.TimeoutHeight, err = cmd.Flags().GetString(flagTimeoutHeight); err != nil {
+				return err
+			}
+			if msg.TimeoutTimestamp, err = cmd.Flags().GetUint64(flagTimeoutTimestamp); err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketData, "", "Data of the forwarded packet, only for packets forwarded before it was recorded")
+	cmd.Flags().String(flagTimeoutHeight, "", "Timeout height of the forwarded packet in the format {revision}-{height}")
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "Timeout timestamp of the forwarded packet in nanoseconds")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	flagPacketData       = "packet-data"
+	flagTimeoutHeight    = "timeout-height"
+	flagTimeoutTimestamp = "timeout-timestamp"
+
+	flagPort    = "port"
+	flagChannel = "channel"
+	flagDenom   = "denom"
+
+	flagSender   = "sender"
+	flagReceiver = "receiver"
+
+	flagAliases        = "aliases"
+	flagBech32Prefixes = "bech32-prefixes"
+	flagBech32Prefix   = "bech32-prefix"
+)
+
+// NewSetPausedCmd returns the command to pause or resume forwarding. The sender must be the module authority.
+func NewSetPausedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paused [true|false]",
+		Short: "Pause or resume forwarding globally or for a channel or denom",
+		Long: `Pause or resume forwarding. Without flags forwarding is paused for all packets, --port and --channel
+pause forwards received on or sent to the channel, and --denom pauses forwards of the denom.
+The sender must be the module authority.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx ibc-router set-paused true --port transfer --channel channel-0 --from authority", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid paused value %s: %w", args[0], err)
+			}
+
+			port, err := cmd.Flags().GetString(flagPort)
+			if err != nil {
+				return err
+			}
+			channel, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(clientCtx.GetFromAddress().String(), types.NewPauseScope(port, channel, denom), paused)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPort, "", "Port of the channel to pause forwarding for")
+	cmd.Flags().String(flagChannel, "", "Channel to pause forwarding for")
+	cmd.Flags().String(flagDenom, "", "Denom to pause forwarding for")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetChainRouteCmd returns the command to add or replace a route of the chain registry. The sender must be the
+// module authority.
+func NewSetChainRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-chain-route [chain-id] [port-id] [channel-id]",
+		Short: "Add or replace the route of a chain in the chain registry",
+		Long: `Add or replace the route of a chain in the chain registry, so that forward metadata can target the chain
+by its chain ID or one of its --aliases instead of the channel. --bech32-prefixes are the bech32 prefixes of
+addresses on the chain. The sender must be the module authority.`,
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(
+			"%s tx ibc-router set-chain-route osmosis-1 transfer channel-141 --aliases osmosis --bech32-prefixes osmo --from authority",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			aliases, err := cmd.Flags().GetStringSlice(flagAliases)
+			if err != nil {
+				return err
+			}
+			bech32Prefixes, err := cmd.Flags().GetStringSlice(flagBech32Prefixes)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetChainRoute(clientCtx.GetFromAddress().String(), types.ChainRoute{
+				ChainId:        args[0],
+				Port:           args[1],
+				Channel:        args[2],
+				Aliases:        aliases,
+				Bech32Prefixes: bech32Prefixes,
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAliases, nil, "Comma separated aliases of the chain")
+	cmd.Flags().StringSlice(flagBech32Prefixes, nil, "Comma separated bech32 prefixes of addresses on the chain")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveChainRouteCmd returns the command to remove a route of the chain registry. The sender must be the module
+// authority.
+func NewRemoveChainRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-chain-route [chain-id]",
+		Short:   "Remove the route of a chain from the chain registry",
+		Long:    "Remove the route of a chain from the chain registry along with its aliases and bech32 prefixes. The sender must be the module authority.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx ibc-router remove-chain-route osmosis-1 --from authority", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveChainRoute(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
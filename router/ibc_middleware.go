@@ -1,9 +1,9 @@
 package router
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
+	"math"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -31,15 +31,20 @@ type IBCMiddleware struct {
 	retriesOnTimeout uint8
 	forwardTimeout   time.Duration
 	refundTimeout    time.Duration
+
+	forwardTimeoutHeightOffset uint64
 }
 
 // NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
+// forwardTimeoutHeightOffset is the number of blocks after the latest height of the next hop's client that
+// forwards without a timeout_height time out at, or 0 for forwards to only time out on timestamp.
 func NewIBCMiddleware(
 	app porttypes.IBCModule,
 	k *keeper.Keeper,
 	retriesOnTimeout uint8,
 	forwardTimeout time.Duration,
 	refundTimeout time.Duration,
+	forwardTimeoutHeightOffset uint64,
 ) IBCMiddleware {
 	return IBCMiddleware{
 		app:              app,
@@ -47,6 +52,8 @@ func NewIBCMiddleware(
 		retriesOnTimeout: retriesOnTimeout,
 		forwardTimeout:   forwardTimeout,
 		refundTimeout:    refundTimeout,
+
+		forwardTimeoutHeightOffset: forwardTimeoutHeightOffset,
 	}
 }
 
@@ -103,21 +110,20 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 }
 
 func getDenomForThisChain(port, channel, counterpartyPort, counterpartyChannel, denom string) string {
-	counterpartyPrefix := transfertypes.GetDenomPrefix(counterpartyPort, counterpartyChannel)
-	if strings.HasPrefix(denom, counterpartyPrefix) {
-		// unwind denom
-		unwoundDenom := denom[len(counterpartyPrefix):]
-		denomTrace := transfertypes.ParseDenomTrace(unwoundDenom)
-		if denomTrace.Path == "" {
-			// denom is now unwound back to native denom
-			return unwoundDenom
-		}
-		// denom is still IBC denom
-		return denomTrace.IBCDenom()
+	// the denom is still an IBC denom unless it was unwound back to the native denom.
+	return transfertypes.ParseDenomTrace(
+		types.ReceivedDenomPath(port, channel, counterpartyPort, counterpartyChannel, denom),
+	).IBCDenom()
+}
+
+// setReceiver replaces the receiver of transfer packet data.
+func setReceiver(data types.ForwardPacketData, receiver string) {
+	switch data := data.(type) {
+	case *transfertypes.FungibleTokenPacketData:
+		data.Receiver = receiver
+	case *types.NonFungibleTokenPacketData:
+		data.Receiver = receiver
 	}
-	// append port and channel from this chain to denom
-	prefixedDenom := transfertypes.GetDenomPrefix(port, channel) + denom
-	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
 }
 
 // getBoolFromAny returns the bool value is any is a valid bool, otherwise false.
@@ -140,8 +146,9 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	var data transfertypes.FungibleTokenPacketData
-	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	// ICS-20 and ICS-721 packets are forwarded the same way, see types.ForwardPacketData.
+	data, err := types.ParseForwardPacketData(packet.GetData())
+	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
@@ -149,82 +156,215 @@ func (im IBCMiddleware) OnRecvPacket(
 		"sequence", packet.Sequence,
 		"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
 		"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
-		"amount", data.Amount, "denom", data.Denom, "memo", data.Memo,
+		"amount", data.GetAmount(), "denom", data.GetDenom(), "memo", data.GetMemo(),
 	)
 
-	d := make(map[string]interface{})
-	err := json.Unmarshal([]byte(data.Memo), &d)
-	if err != nil || d["forward"] == nil {
+	// oversized memos are never decoded. They are rejected if they may hold forward metadata, and passed on to the
+	// underlying app otherwise, as they are not forwarded either way.
+	if err := im.keeper.CheckMemoSize(ctx, data.GetMemo()); err != nil {
+		if !types.MayHaveForwardKeys(data.GetMemo()) {
+			return im.app.OnRecvPacket(ctx, packet, relayer)
+		}
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	m, ok, err := types.ParsePacketMetadata(data.GetMemo())
+	if !ok {
 		// not a packet that should be forwarded
 		im.keeper.Logger(ctx).Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
-	m := &types.PacketMetadata{}
-	err = json.Unmarshal([]byte(data.Memo), m)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("packetForwardMiddleware error parsing forward metadata, %s", err))
 	}
 
-	metadata := m.Forward
+	// NFTs are forwarded as a whole to a single next hop, and cannot be unwound.
+	if _, ok := data.(*types.NonFungibleTokenPacketData); ok {
+		if len(m.Splits) > 0 {
+			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("packetForwardMiddleware splits are not supported for NFT transfers"))
+		}
+		if m.Forward != nil && m.Forward.Unwind {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrNotSupported, "packetForwardMiddleware unwind is only supported for fungible tokens"))
+		}
+	}
 
 	goCtx := ctx.Context()
 	processed := getBoolFromAny(goCtx.Value(types.ProcessedKey{}))
 	nonrefundable := getBoolFromAny(goCtx.Value(types.NonrefundableKey{}))
 	disableDenomComposition := getBoolFromAny(goCtx.Value(types.DisableDenomCompositionKey{}))
 
-	if err := metadata.Validate(); err != nil {
+	// the denom trace of the token is needed to unwind it. With denom composition disabled, the denom is already
+	// the one on this chain, whose trace is resolved when unwinding.
+	denomPath := data.GetDenom()
+	if !disableDenomComposition {
+		denomPath = types.ReceivedDenomPath(
+			packet.DestinationPort, packet.DestinationChannel,
+			packet.SourcePort, packet.SourceChannel,
+			data.GetDenom(),
+		)
+	}
+
+	// unwinds and chains of the registry are resolved to the channels they are validated against.
+	forwards, err := im.keeper.ResolveForwards(ctx, m, denomPath)
+	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
+	metadata := forwards[0]
+
+	// if this packet's token denom is already the base denom for some native token on this chain,
+	// we do not need to do any further composition of the denom before forwarding the packet.
+	// Class IDs of NFTs are traced the same way.
+	denomOnThisChain := data.GetDenom()
+	if !disableDenomComposition {
+		denomOnThisChain = getDenomForThisChain(
+			packet.DestinationPort, packet.DestinationChannel,
+			packet.SourcePort, packet.SourceChannel,
+			data.GetDenom(),
+		)
+	}
+
+	receivedChannel := types.NewPortChannel(packet.DestinationPort, packet.DestinationChannel)
+	baseDenom := transfertypes.ParseDenomTrace(data.GetDenom()).BaseDenom
+
+	// failover channels are checked upfront, as the forward may be sent over any of them.
+	for _, forward := range forwards {
+		if err := im.keeper.CheckForward(ctx, receivedChannel, forward, baseDenom, denomOnThisChain); err != nil {
+			if errors.Is(err, types.ErrForwardingPaused) && im.keeper.GetParams(ctx).PausedForwardBehavior == types.PausedForwardBehaviorPassThrough {
+				im.keeper.Logger(ctx).Info("packetForwardMiddleware forwarding paused, passing packet through",
+					"sequence", packet.Sequence,
+					"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
+					"error", err,
+				)
+				if processed {
+					return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				}
+				return im.app.OnRecvPacket(ctx, packet, relayer)
+			}
+			// forwards not allowed by governance are rejected before the underlying app moves any funds.
+			return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), forward, err)
+		}
+	}
 
 	// if this packet has been handled by another middleware in the stack there may be no need to call into the
 	// underlying app, otherwise the transfer module's OnRecvPacket callback could be invoked more than once
 	// which would mint/burn vouchers more than once
 	if !processed {
+		// the receiver set by the sender is replaced before the underlying app credits it with the funds.
+		if im.keeper.GetParams(ctx).DeriveIntermediateReceiver {
+			setReceiver(data, types.IntermediateReceiver(packet.DestinationChannel, data.GetSender()).String())
+			packet.Data = data.GetBytes()
+		}
+
 		ack := im.app.OnRecvPacket(ctx, packet, relayer)
 		if ack == nil || !ack.Success() {
 			return ack
 		}
 	}
 
-	// if this packet's token denom is already the base denom for some native token on this chain,
-	// we do not need to do any further composition of the denom before forwarding the packet
-	denomOnThisChain := data.Denom
-	if !disableDenomComposition {
-		denomOnThisChain = getDenomForThisChain(
-			packet.DestinationPort, packet.DestinationChannel,
-			packet.SourcePort, packet.SourceChannel,
-			data.Denom,
-		)
+	if len(m.Splits) > 0 {
+		amountInt, ok := sdk.NewIntFromString(data.GetAmount())
+		if !ok {
+			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", data.GetAmount()))
+		}
+		return im.forwardSplits(ctx, packet, data, m, sdk.NewCoin(denomOnThisChain, amountInt), nonrefundable)
 	}
 
-	amountInt, ok := sdk.NewIntFromString(data.Amount)
-	if !ok {
-		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", data.Amount))
+	timeout, retries, err := im.forwardTimeoutAndRetries(ctx, metadata)
+	if err != nil {
+		return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), metadata, err)
+	}
+
+	err = im.keeper.ForwardTransferPacket(
+		ctx, nil, packet, data.GetSender(), data.GetReceiver(), metadata, data, denomOnThisChain,
+		retries, timeout, im.forwardDefaults(ctx).TimeoutHeightOffset, []metrics.Label{}, nonrefundable,
+	)
+	if err != nil {
+		return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), metadata, err)
 	}
 
-	token := sdk.NewCoin(denomOnThisChain, amountInt)
+	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
+	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
+	return nil
+}
 
-	timeout := time.Duration(metadata.Timeout)
+// forwardDefaults returns the retries and timeouts of forwards that do not set their own, set by governance or
+// falling back to the ones the middleware was created with.
+func (im IBCMiddleware) forwardDefaults(ctx sdk.Context) types.ForwardDefaults {
+	if defaults := im.keeper.GetParams(ctx).ForwardDefaults; defaults != nil {
+		return *defaults
+	}
+	return types.ForwardDefaults{
+		Retries:             uint32(im.retriesOnTimeout),
+		Timeout:             im.forwardTimeout,
+		TimeoutHeightOffset: im.forwardTimeoutHeightOffset,
+	}
+}
 
+// forwardTimeoutAndRetries returns the timeout and number of retries of a forward, falling back to the forward
+// defaults when they are not set in the forward metadata. Forwards with failover channels are retried at least once
+// per failover channel. Values outside the forward limits are clamped to them, or rejected if set in the forward
+// metadata and the limits are configured to reject them.
+func (im IBCMiddleware) forwardTimeoutAndRetries(ctx sdk.Context, metadata *types.ForwardMetadata) (time.Duration, uint8, error) {
+	defaults := im.forwardDefaults(ctx)
+
+	timeout := time.Duration(metadata.Timeout)
 	if timeout.Nanoseconds() <= 0 {
-		timeout = im.forwardTimeout
+		timeout = defaults.Timeout
 	}
 
 	var retries uint8
 	if metadata.Retries != nil {
 		retries = *metadata.Retries
 	} else {
-		retries = im.retriesOnTimeout
+		retries = uint8(defaults.Retries)
+	}
+	if failovers := len(metadata.Failover); failovers > int(retries) {
+		retries = math.MaxUint8
+		if failovers < math.MaxUint8 {
+			retries = uint8(failovers)
+		}
 	}
 
-	err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, data.Receiver, metadata, token, retries, timeout, []metrics.Label{}, nonrefundable)
-	if err != nil {
-		return channeltypes.NewErrorAcknowledgement(err)
+	limits := im.keeper.GetParams(ctx).ForwardLimits
+	if limits == nil {
+		return timeout, retries, nil
+	}
+	if err := limits.CheckForward(metadata); err != nil {
+		return 0, 0, err
 	}
 
-	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
-	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
-	return nil
+	return limits.ClampTimeout(timeout), limits.ClampRetries(retries), nil
+}
+
+// rejectForward emits an EventForwardRejected for a packet that will not be forwarded and returns the error
+// acknowledgement to write back to the chain the packet came from.
+func (im IBCMiddleware) rejectForward(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	sender, denom, amount string,
+	metadata *types.ForwardMetadata,
+	err error,
+) ibcexported.Acknowledgement {
+	im.keeper.Logger(ctx).Info("packetForwardMiddleware rejected forward",
+		"sequence", packet.Sequence,
+		"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
+		"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
+		"next-channel", metadata.Channel, "next-port", metadata.Port,
+		"error", err,
+	)
+
+	if emitErr := ctx.EventManager().EmitTypedEvent(&types.EventForwardRejected{
+		OriginalPacket: types.NewOriginalPacketFromPacket(packet, sender),
+		Port:           metadata.Port,
+		Channel:        metadata.Channel,
+		Denom:          denom,
+		Amount:         amount,
+		Error:          err.Error(),
+	}); emitErr != nil {
+		return channeltypes.NewErrorAcknowledgement(emitErr)
+	}
+
+	return channeltypes.NewErrorAcknowledgement(err)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
@@ -234,8 +374,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	var data transfertypes.FungibleTokenPacketData
-	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := types.ParseForwardPacketData(packet.GetData())
+	if err != nil {
 		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from ack packet",
 			"sequence", packet.Sequence,
 			"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
@@ -249,27 +389,54 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		"sequence", packet.Sequence,
 		"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
 		"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
-		"amount", data.Amount, "denom", data.Denom,
+		"amount", data.GetAmount(), "denom", data.GetDenom(),
 	)
 
 	var ack channeltypes.Acknowledgement
 	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal transfer packet acknowledgement: %v", err)
 	}
 
 	inFlightPacket := im.keeper.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
 	if inFlightPacket != nil {
+		if im.keeper.ErrorAckShouldRetry(ctx, inFlightPacket, ack) {
+			// the error is transient on the next hop, so the forward is retried like a timeout, once the transfer
+			// app refunded it on this chain.
+			if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+				return err
+			}
+			return im.keeper.RetryTimeout(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence, data, inFlightPacket)
+		}
+		// the transfer app refunds these forwards to the receiver on this chain before the funds are moved on.
+		if inFlightPacket.RefundedLocally() && !ack.Success() {
+			if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+				return err
+			}
+		}
+		if inFlightPacket.Split {
+			return im.keeper.WriteAcknowledgementForForwardedSplitPacket(ctx, packet, data, inFlightPacket, ack)
+		}
 		// this is a forwarded packet, so override handling to avoid refund from being processed.
 		return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
 	}
 
+	if im.keeper.ClearForceRefundedPacket(ctx, packet) {
+		// this forwarded packet was already refunded by the authority, so the acknowledgement must not be processed again.
+		im.keeper.Logger(ctx).Error("packetForwardMiddleware received acknowledgement for force refunded packet",
+			"sequence", packet.Sequence,
+			"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
+			"success", ack.Success(),
+		)
+		return nil
+	}
+
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	var data transfertypes.FungibleTokenPacketData
-	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	data, err := types.ParseForwardPacketData(packet.GetData())
+	if err != nil {
 		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from timeout packet",
 			"sequence", packet.Sequence,
 			"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
@@ -283,13 +450,22 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		"sequence", packet.Sequence,
 		"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
 		"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
-		"amount", data.Amount, "denom", data.Denom,
+		"amount", data.GetAmount(), "denom", data.GetDenom(),
 	)
 
 	inFlightPacket, err := im.keeper.TimeoutShouldRetry(ctx, packet)
 	if inFlightPacket != nil {
 		if err != nil {
 			im.keeper.RemoveInFlightPacket(ctx, packet)
+			// the transfer app refunds these forwards to the receiver on this chain before the funds are moved on.
+			if inFlightPacket.RefundedLocally() {
+				if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+					return err
+				}
+			}
+			if inFlightPacket.Split {
+				return im.keeper.WriteAcknowledgementForForwardedSplitPacket(ctx, packet, data, inFlightPacket, channeltypes.NewErrorAcknowledgement(err))
+			}
 			// this is a forwarded packet, so override handling to avoid refund from being processed on this chain.
 			// WriteAcknowledgement with proxied ack to return success/fail to previous chain.
 			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, channeltypes.NewErrorAcknowledgement(err))
@@ -298,7 +474,16 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
 			return err
 		}
-		return im.keeper.RetryTimeout(ctx, packet.SourceChannel, packet.SourcePort, data, inFlightPacket)
+		return im.keeper.RetryTimeout(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence, data, inFlightPacket)
+	}
+
+	if im.keeper.ClearForceRefundedPacket(ctx, packet) {
+		// this forwarded packet was already refunded by the authority, so the timeout must not refund it again.
+		im.keeper.Logger(ctx).Info("packetForwardMiddleware received timeout for force refunded packet",
+			"sequence", packet.Sequence,
+			"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
+		)
+		return nil
 	}
 
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EstimateForward implements the Query/EstimateForward gRPC method. Validation and policy errors of the forward
+// are reported in the response rather than failing the query.
+func (k Keeper) EstimateForward(c context.Context, req *types.QueryEstimateForwardRequest) (*types.QueryEstimateForwardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	amount, ok := sdk.NewIntFromString(req.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount %s", req.Amount)
+	}
+	if err := transfertypes.ValidatePrefixedDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// rate limit flows recorded while estimating are discarded along with the cache.
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	received, err := k.receivingChannel(ctx, req.PacketSrcPort, req.PacketSrcChannel)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// the denom is composed the same way as when the packet is received.
+	denomPath := types.ReceivedDenomPath(received.Port, received.Channel, req.PacketSrcPort, req.PacketSrcChannel, req.Denom)
+	trace := transfertypes.ParseDenomTrace(denomPath)
+	res := &types.QueryEstimateForwardResponse{
+		Denom:     trace.IBCDenom(),
+		DenomPath: denomPath,
+	}
+
+	if err := k.CheckMemoSize(ctx, req.Memo); err != nil {
+		res.Errors = append(res.Errors, err.Error())
+		return res, nil
+	}
+	m, ok, err := types.ParsePacketMetadata(req.Memo)
+	if !ok {
+		res.Errors = append(res.Errors, "memo does not hold forward metadata, so the packet is not forwarded")
+		return res, nil
+	}
+	if err != nil {
+		res.Errors = append(res.Errors, fmt.Sprintf("packetForwardMiddleware error parsing forward metadata, %s", err))
+		return res, nil
+	}
+	forwards, err := k.ResolveForwards(ctx, m, denomPath)
+	if err != nil {
+		res.Errors = append(res.Errors, err.Error())
+		return res, nil
+	}
+
+	// the receiver set by the sender is replaced before the funds are credited on this chain.
+	res.Receiver = req.Receiver
+	if k.GetParams(ctx).DeriveIntermediateReceiver {
+		if req.Sender == "" {
+			res.Errors = append(res.Errors, "sender is required to derive the receiver on this chain")
+		} else {
+			res.Receiver = types.IntermediateReceiver(received.Channel, req.Sender).String()
+		}
+	}
+	if res.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(res.Receiver); err != nil {
+			res.Errors = append(res.Errors, fmt.Sprintf("invalid receiver %s on this chain: %s", res.Receiver, err))
+		}
+	}
+
+	amounts := []sdk.Int{amount}
+	if len(m.Splits) > 0 {
+		if _, err := types.ParseSplitAckPolicy(m.SplitAckPolicy); err != nil {
+			res.Errors = append(res.Errors, err.Error())
+		}
+		if amounts, err = types.SplitAmounts(amount, m.Splits); err != nil {
+			res.Errors = append(res.Errors, err.Error())
+			return res, nil
+		}
+	}
+
+	for i, forward := range forwards {
+		estimate, errs := k.estimateForward(ctx, received, trace, forward, amounts[i])
+		res.Forwards = append(res.Forwards, estimate)
+		res.Errors = append(res.Errors, errs...)
+	}
+
+	return res, nil
+}
+
+// estimateForward estimates the forward of amount of the token with the given denom trace on this chain, received
+// on the received channel, and returns the errors it would fail with.
+func (k Keeper) estimateForward(
+	ctx sdk.Context,
+	received types.PortChannel,
+	trace transfertypes.DenomTrace,
+	metadata *types.ForwardMetadata,
+	amount sdk.Int,
+) (types.ForwardEstimate, []string) {
+	params := k.GetParams(ctx)
+	denom := trace.IBCDenom()
+
+	var errs []string
+	if err := k.CheckForward(ctx, received, metadata, trace.BaseDenom, denom); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	// the forward is sent over the first usable channel candidate of a forward with failover channels.
+	var channelErr error
+	if candidates := metadata.ChannelCandidates(); len(candidates) > 0 {
+		selected := *metadata
+		channelErr = k.selectChannelCandidate(ctx, &types.InFlightPacket{ChannelCandidates: candidates}, &selected, false)
+		metadata = &selected
+	} else {
+		channelErr = k.checkChannelActive(ctx, metadata.Port, metadata.Channel)
+	}
+	next := types.NewPortChannel(metadata.Port, metadata.Channel)
+
+	// the denom trace of a voucher this chain has not received yet is not stored, so the fee and flow are looked up
+	// by the base denom of the trace instead.
+	feeAmount := forwardFee(params, metadata.Port, metadata.Channel, trace.BaseDenom, amount)
+	netAmount := amount.Sub(feeAmount)
+	if !netAmount.IsPositive() {
+		errs = append(errs, errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "amount %s is not greater than forwarding fee %s", amount, feeAmount,
+		).Error())
+		netAmount = sdk.ZeroInt()
+	} else if err := k.recordBaseDenomFlow(ctx, params, &types.InFlightPacket{}, received, next, trace.BaseDenom, amount, netAmount); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	estimate := types.ForwardEstimate{
+		Port:     metadata.Port,
+		Channel:  metadata.Channel,
+		Receiver: metadata.Receiver,
+		Fee:      sdk.NewCoin(denom, feeAmount),
+		Amount:   sdk.NewCoin(denom, netAmount),
+	}
+
+	if channelErr != nil {
+		errs = append(errs, channelErr.Error())
+	} else {
+		channel, _ := k.channelKeeper.GetChannel(ctx, metadata.Port, metadata.Channel)
+		estimate.NextDenomPath = types.ReceivedDenomPath(
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, metadata.Port, metadata.Channel,
+			trace.GetFullDenomPath(),
+		)
+		estimate.NextDenom = transfertypes.ParseDenomTrace(estimate.NextDenomPath).IBCDenom()
+	}
+
+	return estimate, errs
+}
+
+// receivingChannel returns the channel on this chain that receives packets sent over the given source port and
+// channel of the counterparty chain. An error is returned if it is not unique, as channels on different
+// counterparty chains can share identifiers.
+func (k Keeper) receivingChannel(ctx sdk.Context, srcPort, srcChannel string) (types.PortChannel, error) {
+	var matches []types.PortChannel
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.Counterparty.PortId == srcPort && channel.Counterparty.ChannelId == srcChannel {
+			matches = append(matches, types.NewPortChannel(channel.PortId, channel.ChannelId))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return types.PortChannel{}, fmt.Errorf("no channel on this chain has counterparty %s", types.NewPortChannel(srcPort, srcChannel))
+	case 1:
+		return matches[0], nil
+	default:
+		channels := make([]string, len(matches))
+		for i, match := range matches {
+			channels[i] = match.String()
+		}
+		return types.PortChannel{}, fmt.Errorf(
+			"channels %s on this chain all have counterparty %s", strings.Join(channels, ", "), types.NewPortChannel(srcPort, srcChannel),
+		)
+	}
+}
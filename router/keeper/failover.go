@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// selectChannelCandidate points metadata at the channel candidate a forward with failover channels is sent over
+// next and records it as active in inFlightPacket. The first open candidate with an active client is selected, starting from the active
+// candidate for the first forward, and from the candidate after it for a retry, so that a forward that timed out or
+// failed moves to the next channel.
+func (k *Keeper) selectChannelCandidate(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	metadata *types.ForwardMetadata,
+	retry bool,
+) error {
+	n := uint32(len(inFlightPacket.ChannelCandidates))
+	start := inFlightPacket.ActiveCandidate
+	if retry {
+		start++
+	}
+
+	for i := uint32(0); i < n; i++ {
+		index := (start + i) % n
+		candidate := inFlightPacket.ChannelCandidates[index]
+
+		if err := k.checkChannelActive(ctx, candidate.Port, candidate.Channel); err != nil {
+			k.Logger(ctx).Info("packetForwardMiddleware skipping channel candidate that is not usable",
+				"port", candidate.Port, "channel", candidate.Channel, "error", err,
+			)
+			continue
+		}
+
+		inFlightPacket.ActiveCandidate = index
+		metadata.Port = candidate.Port
+		metadata.Channel = candidate.Channel
+		metadata.Receiver = candidate.Receiver
+		return nil
+	}
+
+	return fmt.Errorf("none of the %d channel candidates of the forward is open with an active client", n)
+}
+
+// checkChannelActive returns an error if a channel is not open or the client of its connection is not active, e.g.
+// because it expired or was frozen, in which case packets sent over it would never be received.
+func (k *Keeper) checkChannelActive(ctx sdk.Context, port, channel string) error {
+	ch, found := k.channelKeeper.GetChannel(ctx, port, channel)
+	if !found {
+		return fmt.Errorf("channel %s/%s not found", port, channel)
+	}
+	if ch.State != channeltypes.OPEN {
+		return fmt.Errorf("channel %s/%s is %s", port, channel, ch.State)
+	}
+
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, port, channel)
+	if err != nil {
+		return err
+	}
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, clientID); status != ibcexported.Active {
+		return fmt.Errorf("client %s of channel %s/%s is %s", clientID, port, channel, status)
+	}
+	return nil
+}
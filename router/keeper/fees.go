@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// GetForwardFee returns the fee charged for forwarding token to the destination port and channel. The most
+// specific matching entry of the fee schedule is used, falling back to the global fee percentage.
+func (k Keeper) GetForwardFee(ctx sdk.Context, port, channel string, token sdk.Coin) (sdk.Int, error) {
+	params := k.GetParams(ctx)
+
+	// resolving the base denom of an IBC voucher requires a denom trace lookup, so only do it when needed.
+	baseDenom := token.Denom
+	if params.HasDenomFeeSchedule() {
+		var err error
+		baseDenom, err = k.baseDenom(ctx, token.Denom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	return forwardFee(params, port, channel, baseDenom, token.Amount), nil
+}
+
+// forwardFee returns the fee charged for forwarding amount of a token with the given base denom to the destination
+// port and channel.
+func forwardFee(params types.Params, port, channel, baseDenom string, amount sdk.Int) sdk.Int {
+	if entry, found := params.FeeScheduleEntry(port, channel, baseDenom); found {
+		return entry.Fee(amount)
+	}
+
+	return sdk.NewDecFromInt(amount).Mul(params.FeePercentage).RoundInt()
+}
+
+// validateFeeRecipient returns an error if fees cannot be paid to the fee recipient on this chain: a module account
+// that is not registered with the account keeper, or the community pool without a distribution keeper.
+func (k Keeper) validateFeeRecipient(recipient types.FeeRecipient) error {
+	switch recipient.Type {
+	case types.FeeRecipientTypeCommunityPool:
+		if k.distrKeeper == nil {
+			return fmt.Errorf("fee recipient is the community pool but no distribution keeper is configured")
+		}
+	case types.FeeRecipientTypeModuleAccount:
+		if k.accountKeeper.GetModuleAddress(recipient.ModuleName) == nil {
+			return fmt.Errorf("fee recipient module account %s is not registered", recipient.ModuleName)
+		}
+	}
+	return nil
+}
+
+// payForwardFee sends the forwarding fee from payer to the fee recipient configured in the module params. It
+// returns the recipient to report in EventFeeCollected.
+func (k Keeper) payForwardFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) (string, error) {
+	recipient := k.GetParams(ctx).FeeRecipient
+
+	switch recipient.Type {
+	case types.FeeRecipientTypeCommunityPool:
+		if k.distrKeeper == nil {
+			return "", fmt.Errorf("fee recipient is the community pool but no distribution keeper is configured")
+		}
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, payer); err != nil {
+			return "", err
+		}
+		return types.FeeRecipientCommunityPool, nil
+
+	case types.FeeRecipientTypeModuleAccount:
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, recipient.ModuleName, fee); err != nil {
+			return "", err
+		}
+		return authtypes.NewModuleAddress(recipient.ModuleName).String(), nil
+
+	case types.FeeRecipientTypeAddress:
+		recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return "", err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, payer, recipientAddr, fee); err != nil {
+			return "", err
+		}
+		return recipient.Address, nil
+
+	case types.FeeRecipientTypeBurn:
+		// burn through the transfer module account, which is granted burner permissions for refunds.
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, transfertypes.ModuleName, fee); err != nil {
+			return "", err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, fee); err != nil {
+			return "", err
+		}
+		return types.FeeRecipientBurn, nil
+
+	default:
+		return "", fmt.Errorf("unknown fee recipient type %d", recipient.Type)
+	}
+}
+
+// tryPayForwardFee pays the forwarding fee like payForwardFee in a cache context, which is only written if the fee
+// is paid. Panics while paying the fee, e.g. of the bank keeper for a module account that does not exist, are
+// returned as errors.
+func (k Keeper) tryPayForwardFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) (recipient string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			recipient, err = "", fmt.Errorf("panic paying forward fee: %v", r)
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if recipient, err = k.payForwardFee(cacheCtx, payer, fee); err != nil {
+		return "", err
+	}
+	writeCache()
+	return recipient, nil
+}
+
+// collectForwardFee pays the fee held in escrow for a forward that succeeded to the fee recipient configured in the
+// module params. payer is the forwarder on this chain the fee was charged to. A failed payment does not fail the
+// forward, whose acknowledgement is written regardless: the fee is left in the fee escrow account instead.
+func (k Keeper) collectForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket, payer string) error {
+	if inFlightPacket.Fee.IsZero() {
+		return nil
+	}
+
+	recipient, err := k.tryPayForwardFee(ctx, types.FeeEscrowAddress(), inFlightPacket.Fee)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware error paying forward fee, leaving it in the fee escrow account",
+			"original-sender-address", inFlightPacket.OriginalSenderAddress,
+			"refund-channel-id", inFlightPacket.RefundChannelId,
+			"refund-port-id", inFlightPacket.RefundPortId,
+			"fee", inFlightPacket.Fee.String(),
+			"error", err,
+		)
+		return nil
+	}
+
+	for _, fee := range inFlightPacket.Fee {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventFeeCollected{
+			OriginalPacket: types.NewOriginalPacket(inFlightPacket),
+			Denom:          fee.Denom,
+			Amount:         fee.Amount.String(),
+			Payer:          payer,
+			Recipient:      recipient,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// returnForwardFee sends the fee held in escrow for a forward that failed to an address on this chain, along with
+// the funds of the forward.
+func (k Keeper) returnForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket, to sdk.AccAddress) error {
+	if inFlightPacket.Fee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, types.FeeEscrowAddress(), to, inFlightPacket.Fee); err != nil {
+		return fmt.Errorf("failed to return forward fee: %w", err)
+	}
+	return nil
+}
+
+// refundForwardFee refunds the fee held in escrow for a forward along with the received packet, reversing its
+// receipt: a token unescrowed when received goes back to the escrow account of the channel it was received on,
+// and a voucher minted when received is burned.
+func (k Keeper) refundForwardFee(ctx sdk.Context, inFlightPacket *types.InFlightPacket, fullDenomPath string) error {
+	if inFlightPacket.Fee.IsZero() {
+		return nil
+	}
+
+	if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
+		refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+		if err := k.bankKeeper.SendCoins(ctx, types.FeeEscrowAddress(), refundEscrowAddress, inFlightPacket.Fee); err != nil {
+			return fmt.Errorf("failed to send forward fee to refund escrow account: %w", err)
+		}
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.FeeEscrowAddress(), transfertypes.ModuleName, inFlightPacket.Fee); err != nil {
+		return fmt.Errorf("failed to send forward fee to module account for burn: %w", err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, inFlightPacket.Fee); err != nil {
+		return fmt.Errorf("failed to burn forward fee: %w", err)
+	}
+	return nil
+}
+
+// baseDenom returns the base denom of a local denom, resolving the denom trace of IBC vouchers.
+func (k Keeper) baseDenom(ctx sdk.Context, denom string) (string, error) {
+	if strings.HasPrefix(denom, "ibc/") {
+		fullPath, err := k.transferKeeper.DenomPathFromHash(ctx, denom)
+		if err != nil {
+			return "", err
+		}
+		return transfertypes.ParseDenomTrace(fullPath).BaseDenom, nil
+	}
+	return denom, nil
+}
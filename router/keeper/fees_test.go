@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/golang/mock/gomock"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetForwardFee(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+
+	params := types.NewParams(sdk.NewDecWithPrec(1, 2))
+	params.FeeSchedule = []types.FeeScheduleEntry{
+		{Port: "transfer", Channel: "channel-1", FeePercentage: sdk.NewDecWithPrec(5, 2)},
+		{Denom: "uatom", FeePercentage: sdk.NewDecWithPrec(10, 2), MinFee: sdk.NewInt(3)},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-5/uatom")
+	setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, voucher.IBCDenom()).
+		Return(voucher.GetFullDenomPath(), nil).Times(2)
+
+	// global fee percentage
+	fee, err := k.GetForwardFee(ctx, "transfer", "channel-0", sdk.NewCoin("stake", sdk.NewInt(1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(10), fee)
+
+	// channel entry
+	fee, err = k.GetForwardFee(ctx, "transfer", "channel-1", sdk.NewCoin("stake", sdk.NewInt(1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(50), fee)
+
+	// denom entry matched by the base denom of an IBC voucher, with its minimum fee
+	fee, err = k.GetForwardFee(ctx, "transfer", "channel-0", sdk.NewCoin(voucher.IBCDenom(), sdk.NewInt(1000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), fee)
+
+	fee, err = k.GetForwardFee(ctx, "transfer", "channel-0", sdk.NewCoin(voucher.IBCDenom(), sdk.NewInt(10)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(3), fee)
+}
@@ -7,29 +7,87 @@ import (
 
 // InitGenesis
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
-	k.SetParams(ctx, state.Params)
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		panic(err)
+	}
 
 	// Initialize store refund path for forwarded packets in genesis state that have not yet been acked.
-	store := ctx.KVStore(k.storeKey)
+	store := k.inFlightPacketStore(ctx)
 	for key, value := range state.InFlightPackets {
 		key := key
 		value := value
 		bz := k.cdc.MustMarshal(&value)
 		store.Set([]byte(key), bz)
+
+		if value.RetryTime != nil {
+			channel, port, sequence, err := types.ParseRefundPacketKey([]byte(key))
+			if err != nil {
+				panic(err)
+			}
+			k.retryQueueStore(ctx).Set(types.RetryQueueKey(*value.RetryTime, channel, port, sequence), []byte{0x01})
+		}
+	}
+
+	for _, scope := range state.Paused {
+		k.SetPaused(ctx, scope, true)
+	}
+
+	splitForwardStore := k.splitForwardStore(ctx)
+	for key, value := range state.SplitForwards {
+		value := value
+		splitForwardStore.Set([]byte(key), k.cdc.MustMarshal(&value))
+	}
+
+	for _, route := range state.ChainRoutes {
+		if err := k.SetChainRoute(ctx, route); err != nil {
+			panic(err)
+		}
+	}
+
+	forceRefundedPacketStore := k.forceRefundedPacketStore(ctx)
+	for _, key := range state.ForceRefundedPackets {
+		forceRefundedPacketStore.Set([]byte(key), []byte{0x01})
 	}
 }
 
 // ExportGenesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	store := ctx.KVStore(k.storeKey)
+	store := k.inFlightPacketStore(ctx)
 
 	inFlightPackets := make(map[string]types.InFlightPacket)
 
 	itr := store.Iterator(nil, nil)
+	defer itr.Close()
 	for ; itr.Valid(); itr.Next() {
 		var inFlightPacket types.InFlightPacket
 		k.cdc.MustUnmarshal(itr.Value(), &inFlightPacket)
 		inFlightPackets[string(itr.Key())] = inFlightPacket
 	}
-	return &types.GenesisState{Params: k.GetParams(ctx), InFlightPackets: inFlightPackets}
+
+	splitForwards := make(map[string]types.SplitForward)
+
+	splitItr := k.splitForwardStore(ctx).Iterator(nil, nil)
+	defer splitItr.Close()
+	for ; splitItr.Valid(); splitItr.Next() {
+		var splitForward types.SplitForward
+		k.cdc.MustUnmarshal(splitItr.Value(), &splitForward)
+		splitForwards[string(splitItr.Key())] = splitForward
+	}
+
+	var forceRefundedPackets []string
+
+	forceRefundedItr := k.forceRefundedPacketStore(ctx).Iterator(nil, nil)
+	defer forceRefundedItr.Close()
+	for ; forceRefundedItr.Valid(); forceRefundedItr.Next() {
+		forceRefundedPackets = append(forceRefundedPackets, string(forceRefundedItr.Key()))
+	}
+
+	return &types.GenesisState{
+		Params:               k.GetParams(ctx),
+		InFlightPackets:      inFlightPackets,
+		Paused:               k.GetAllPaused(ctx),
+		SplitForwards:        splitForwards,
+		ChainRoutes:          k.GetAllChainRoutes(ctx),
+		ForceRefundedPackets: forceRefundedPackets,
+	}
 }
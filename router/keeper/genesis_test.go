@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisForceRefundedPackets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+
+	genesis := types.DefaultGenesisState()
+	genesis.InFlightPackets = map[string]types.InFlightPacket{
+		string(types.RefundPacketKey("channel-0", "transfer", 1)): {OriginalSenderAddress: testSender, RefundChannelId: "channel-10"},
+	}
+	genesis.ForceRefundedPackets = []string{string(types.RefundPacketKey("channel-0", "transfer", 2))}
+	require.NoError(t, genesis.Validate())
+	k.InitGenesis(ctx, *genesis)
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, genesis.InFlightPackets, exported.InFlightPackets)
+	require.Equal(t, genesis.ForceRefundedPackets, exported.ForceRefundedPackets)
+
+	// the acknowledgement or timeout of the force refunded packet is still ignored after the import.
+	packet := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0", Sequence: 2}
+	require.True(t, k.ClearForceRefundedPacket(ctx, packet))
+	require.False(t, k.ClearForceRefundedPacket(ctx, packet))
+	require.Empty(t, k.ExportGenesis(ctx).ForceRefundedPackets)
+
+	genesis.ForceRefundedPackets = []string{"channel-0/transfer"}
+	require.Error(t, genesis.Validate())
+}
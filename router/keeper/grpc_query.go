@@ -3,8 +3,13 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -17,3 +22,181 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InFlightPackets implements the Query/InFlightPackets gRPC method
+func (k Keeper) InFlightPackets(c context.Context, req *types.QueryInFlightPacketsRequest) (*types.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var inFlightPackets []types.IdentifiedInFlightPacket
+	pageRes, err := query.Paginate(k.inFlightPacketStore(ctx), req.Pagination, func(key, value []byte) error {
+		identified, err := k.identifiedInFlightPacket(key, value)
+		if err != nil {
+			return err
+		}
+		inFlightPackets = append(inFlightPackets, identified)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsResponse{
+		InFlightPackets: inFlightPackets,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// InFlightPacket implements the Query/InFlightPacket gRPC method
+func (k Keeper) InFlightPacket(c context.Context, req *types.QueryInFlightPacketRequest) (*types.QueryInFlightPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	inFlightPacket, found := k.GetInFlightPacket(ctx, req.ChannelId, req.PortId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(sdkerrors.ErrNotFound, "in-flight packet on channel (%s) port (%s) sequence (%d)", req.ChannelId, req.PortId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryInFlightPacketResponse{
+		InFlightPacket: types.IdentifiedInFlightPacket{
+			ChannelId:      req.ChannelId,
+			PortId:         req.PortId,
+			Sequence:       req.Sequence,
+			InFlightPacket: inFlightPacket,
+		},
+	}, nil
+}
+
+// InFlightPacketsByOriginalSender implements the Query/InFlightPacketsByOriginalSender gRPC method
+func (k Keeper) InFlightPacketsByOriginalSender(
+	c context.Context,
+	req *types.QueryInFlightPacketsByOriginalSenderRequest,
+) (*types.QueryInFlightPacketsByOriginalSenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.OriginalSenderAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "original sender address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var inFlightPackets []types.IdentifiedInFlightPacket
+	pageRes, err := query.FilteredPaginate(k.inFlightPacketStore(ctx), req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		identified, err := k.identifiedInFlightPacket(key, value)
+		if err != nil {
+			return false, err
+		}
+		if identified.InFlightPacket.OriginalSenderAddress != req.OriginalSenderAddress {
+			return false, nil
+		}
+		if accumulate {
+			inFlightPackets = append(inFlightPackets, identified)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsByOriginalSenderResponse{
+		InFlightPackets: inFlightPackets,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// Paused implements the Query/Paused gRPC method
+func (k Keeper) Paused(c context.Context, req *types.QueryPausedRequest) (*types.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPausedResponse{
+		Paused: k.GetAllPaused(ctx),
+	}, nil
+}
+
+// ChainRoutes implements the Query/ChainRoutes gRPC method
+func (k Keeper) ChainRoutes(c context.Context, req *types.QueryChainRoutesRequest) (*types.QueryChainRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryChainRoutesResponse{
+		ChainRoutes: k.GetAllChainRoutes(ctx),
+	}, nil
+}
+
+// ChainRoute implements the Query/ChainRoute gRPC method
+func (k Keeper) ChainRoute(c context.Context, req *types.QueryChainRouteRequest) (*types.QueryChainRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	route, found := k.ResolveChain(ctx, req.Chain)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrUnknownChain, "chain %s", req.Chain).Error())
+	}
+
+	return &types.QueryChainRouteResponse{
+		ChainRoute: route,
+	}, nil
+}
+
+// ChainRouteByBech32Prefix implements the Query/ChainRouteByBech32Prefix gRPC method
+func (k Keeper) ChainRouteByBech32Prefix(
+	c context.Context,
+	req *types.QueryChainRouteByBech32PrefixRequest,
+) (*types.QueryChainRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	route, found := k.GetChainRouteByBech32Prefix(ctx, req.Bech32Prefix)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound, errorsmod.Wrapf(types.ErrUnknownChain, "bech32 prefix %s", req.Bech32Prefix).Error(),
+		)
+	}
+
+	return &types.QueryChainRouteResponse{
+		ChainRoute: route,
+	}, nil
+}
+
+// identifiedInFlightPacket decodes an in-flight packet store entry along with the identifiers in its key.
+func (k Keeper) identifiedInFlightPacket(key, value []byte) (types.IdentifiedInFlightPacket, error) {
+	channelID, portID, sequence, err := types.ParseRefundPacketKey(key)
+	if err != nil {
+		return types.IdentifiedInFlightPacket{}, err
+	}
+
+	var inFlightPacket types.InFlightPacket
+	if err := k.cdc.Unmarshal(value, &inFlightPacket); err != nil {
+		return types.IdentifiedInFlightPacket{}, err
+	}
+
+	return types.IdentifiedInFlightPacket{
+		ChannelId:      channelID,
+		PortId:         portID,
+		Sequence:       sequence,
+		InFlightPacket: inFlightPacket,
+	}, nil
+}
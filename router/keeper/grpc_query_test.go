@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSender      = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+	testOtherSender = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+)
+
+func TestQueryInFlightPackets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+
+	genesis := types.DefaultGenesisState()
+	genesis.InFlightPackets = map[string]types.InFlightPacket{
+		string(types.RefundPacketKey("channel-0", "transfer", 1)): {OriginalSenderAddress: testSender, RefundChannelId: "channel-10"},
+		string(types.RefundPacketKey("channel-0", "transfer", 2)): {OriginalSenderAddress: testOtherSender, RefundChannelId: "channel-10"},
+		string(types.RefundPacketKey("channel-1", "transfer", 1)): {OriginalSenderAddress: testSender, RefundChannelId: "channel-11"},
+	}
+	k.InitGenesis(ctx, *genesis)
+
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	res, err := k.InFlightPackets(goCtx, &types.QueryInFlightPacketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.InFlightPackets, 3)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	paged, err := k.InFlightPackets(goCtx, &types.QueryInFlightPacketsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, paged.InFlightPackets, 2)
+	require.NotNil(t, paged.Pagination.NextKey)
+
+	single, err := k.InFlightPacket(goCtx, &types.QueryInFlightPacketRequest{
+		ChannelId: "channel-1",
+		PortId:    "transfer",
+		Sequence:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", single.InFlightPacket.ChannelId)
+	require.Equal(t, "transfer", single.InFlightPacket.PortId)
+	require.Equal(t, uint64(1), single.InFlightPacket.Sequence)
+	require.Equal(t, "channel-11", single.InFlightPacket.InFlightPacket.RefundChannelId)
+
+	_, err = k.InFlightPacket(goCtx, &types.QueryInFlightPacketRequest{
+		ChannelId: "channel-1",
+		PortId:    "transfer",
+		Sequence:  2,
+	})
+	require.Error(t, err)
+
+	bySender, err := k.InFlightPacketsByOriginalSender(goCtx, &types.QueryInFlightPacketsByOriginalSenderRequest{
+		OriginalSenderAddress: testSender,
+	})
+	require.NoError(t, err)
+	require.Len(t, bySender.InFlightPackets, 2)
+	for _, p := range bySender.InFlightPackets {
+		require.Equal(t, testSender, p.InFlightPacket.OriginalSenderAddress)
+	}
+
+	_, err = k.InFlightPacketsByOriginalSender(goCtx, &types.QueryInFlightPacketsByOriginalSenderRequest{})
+	require.Error(t, err)
+}
+
+func TestQueryEstimateForward(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	nextChannel := channeltypes.Channel{
+		State:        channeltypes.OPEN,
+		Counterparty: channeltypes.NewCounterparty("transfer", "channel-5"),
+	}
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetAllChannels(gomock.Any()).Return([]channeltypes.IdentifiedChannel{
+		channeltypes.NewIdentifiedChannel("transfer", "channel-11", channeltypes.Channel{
+			State:        channeltypes.OPEN,
+			Counterparty: channeltypes.NewCounterparty("transfer", "channel-10"),
+		}),
+		channeltypes.NewIdentifiedChannel("transfer", "channel-0", nextChannel),
+	}).AnyTimes()
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(gomock.Any(), "transfer", "channel-0").Return(nextChannel, true).AnyTimes()
+	clientState := &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1000)}
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(gomock.Any(), "transfer", "channel-0").
+		Return("07-tendermint-0", clientState, nil).AnyTimes()
+	setup.Mocks.ClientKeeperMock.EXPECT().GetClientStatus(gomock.Any(), clientState, "07-tendermint-0").
+		Return(ibcexported.Active).AnyTimes()
+
+	params := types.NewParams(sdk.NewDecWithPrec(5, 2))
+	require.NoError(t, k.SetParams(ctx, params))
+
+	const memo = `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0"}}`
+	req := &types.QueryEstimateForwardRequest{
+		PacketSrcPort:    "transfer",
+		PacketSrcChannel: "channel-10",
+		Denom:            "uatom",
+		Amount:           "100",
+		Memo:             memo,
+	}
+
+	res, err := k.EstimateForward(goCtx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.Equal(t, "transfer/channel-11/uatom", res.DenomPath)
+	require.Equal(t, transfertypes.ParseDenomTrace(res.DenomPath).IBCDenom(), res.Denom)
+	require.Len(t, res.Forwards, 1)
+	require.Equal(t, sdk.NewCoin(res.Denom, sdk.NewInt(5)), res.Forwards[0].Fee)
+	require.Equal(t, sdk.NewCoin(res.Denom, sdk.NewInt(95)), res.Forwards[0].Amount)
+	require.Equal(t, "transfer/channel-5/transfer/channel-11/uatom", res.Forwards[0].NextDenomPath)
+
+	// the forward is validated the same way as when the packet is received: forward keys are matched exactly, the
+	// routing policy applies to failover channels and retries set in the memo are checked against the limits.
+	for _, memo := range []string{
+		`{"Forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0"}}`,
+		`{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","failover":[{"channel":"channel-2"}]}}`,
+		`{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","retries":5}}`,
+	} {
+		params.RoutingPolicy.DeniedChannels = []types.PortChannel{types.NewPortChannel("transfer", "channel-2")}
+		params.ForwardLimits = &types.ForwardLimits{MaxRetries: 3, Behavior: types.ForwardLimitBehaviorReject}
+		require.NoError(t, k.SetParams(ctx, params))
+		req.Memo = memo
+		res, err = k.EstimateForward(goCtx, req)
+		require.NoError(t, err)
+		require.Len(t, res.Errors, 1, memo)
+	}
+	params.RoutingPolicy.DeniedChannels = nil
+	params.ForwardLimits = nil
+
+	// the receiver on this chain is derived from the sender when the param is set.
+	params.DeriveIntermediateReceiver = true
+	require.NoError(t, k.SetParams(ctx, params))
+	req.Memo = memo
+	res, err = k.EstimateForward(goCtx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Errors, 1)
+	req.Sender = testSender
+	res, err = k.EstimateForward(goCtx, req)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.Equal(t, types.IntermediateReceiver("channel-11", req.Sender).String(), res.Receiver)
+	params.DeriveIntermediateReceiver = false
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// a voucher returning to this chain is unwound before it is forwarded.
+	req.Denom = "transfer/channel-10/uosmo"
+	res, err = k.EstimateForward(goCtx, req)
+	require.NoError(t, err)
+	require.Equal(t, "uosmo", res.Denom)
+	require.Equal(t, "transfer/channel-5/uosmo", res.Forwards[0].NextDenomPath)
+
+	// policy errors are reported without failing the query.
+	params.RoutingPolicy.DeniedDenoms = []string{"uosmo"}
+	require.NoError(t, k.SetParams(ctx, params))
+	res, err = k.EstimateForward(goCtx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Errors, 1)
+
+	req.Memo = `{"forward":{"port":"transfer","channel":"channel-0"}}`
+	res, err = k.EstimateForward(goCtx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Errors)
+	require.Empty(t, res.Forwards)
+
+	req.PacketSrcChannel = "channel-12"
+	_, err = k.EstimateForward(goCtx, req)
+	require.Error(t, err)
+}
@@ -1,21 +1,21 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
@@ -41,42 +41,51 @@ var (
 
 // Keeper defines the packet forward middleware keeper
 type Keeper struct {
-	cdc        codec.BinaryCodec
-	storeKey   storetypes.StoreKey
-	paramSpace paramtypes.Subspace
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
 
 	transferKeeper types.TransferKeeper
 	channelKeeper  types.ChannelKeeper
+	clientKeeper   types.ClientKeeper
 	distrKeeper    types.DistributionKeeper
+	accountKeeper  types.AccountKeeper
 	bankKeeper     types.BankKeeper
 	ics4Wrapper    porttypes.ICS4Wrapper
+
+	// optional keepers used to forward ICS-721 NFT packets.
+	nftTransferKeeper types.NFTTransferKeeper
+	nftKeeper         types.NFTKeeper
+
+	// the address capable of executing privileged messages such as MsgUpdateParams. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
-// NewKeeper creates a new forward Keeper instance
+// NewKeeper creates a new forward Keeper instance. distrKeeper may be nil on chains without a distribution
+// module, as long as the fee recipient param is not the community pool.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
-	paramSpace paramtypes.Subspace,
 	transferKeeper types.TransferKeeper,
 	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
 	distrKeeper types.DistributionKeeper,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	ics4Wrapper porttypes.ICS4Wrapper,
+	authority string,
 ) *Keeper {
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       key,
 		transferKeeper: transferKeeper,
 		channelKeeper:  channelKeeper,
-		paramSpace:     paramSpace,
+		clientKeeper:   clientKeeper,
 		distrKeeper:    distrKeeper,
+		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
 		ics4Wrapper:    ics4Wrapper,
+		authority:      authority,
 	}
 }
 
@@ -85,15 +94,42 @@ func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
 	k.transferKeeper = transferKeeper
 }
 
+// SetNFTKeepers sets the keepers used to forward ICS-721 NFT packets. It only needs to be called on chains that
+// wire the middleware into an ICS-721 transfer stack.
+func (k *Keeper) SetNFTKeepers(nftTransferKeeper types.NFTTransferKeeper, nftKeeper types.NFTKeeper) {
+	k.nftTransferKeeper = nftTransferKeeper
+	k.nftKeeper = nftKeeper
+}
+
+// GetAuthority returns the module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
 }
 
+// inFlightPacketStore returns the store holding in-flight packets keyed by RefundPacketKey.
+func (k *Keeper) inFlightPacketStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.InFlightPacketKeyPrefix)
+}
+
+// forceRefundedPacketStore returns the store holding forwarded packets that were refunded by the authority,
+// keyed by RefundPacketKey.
+func (k *Keeper) forceRefundedPacketStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ForceRefundedPacketKeyPrefix)
+}
+
+// WriteAcknowledgementForForwardedPacket writes the acknowledgement of the original packet a forwarded packet was
+// forwarding, once the forwarded packet is acknowledged or times out. On failure, the tokens of the forward are
+// refunded, credited to its recover address, or kept on this chain if the forward is nonrefundable. data is the
+// packet data of the forwarded packet.
 func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
-	data transfertypes.FungibleTokenPacketData,
+	data types.ForwardPacketData,
 	inFlightPacket *types.InFlightPacket,
 	ack channeltypes.Acknowledgement,
 ) error {
@@ -103,84 +139,210 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 		return errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
+	originalPacket := types.NewOriginalPacket(inFlightPacket)
+	nextHop := types.NewNextHopPacket(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
 	// for forwarded packets, the funds were moved into an escrow account if the denom originated on this chain.
 	// On an ack error or timeout on a forwarded packet, the funds in the escrow account
 	// should be moved to the other escrow account on the other side or burned.
 	if !ack.Success() {
+		tokens, err := k.packetTokens(data, data.GetDenom())
+		if err != nil {
+			return err
+		}
+
+		// deconstruct the token denomination into the denomination trace info
+		// to determine if the sender is the source chain
+		fullDenomPath, err := tokens.fullPath(ctx)
+		if err != nil {
+			return err
+		}
+		next := types.NewPortChannel(packet.SourcePort, packet.SourceChannel)
+
+		if inFlightPacket.RecoverAddress != "" {
+			// the transfer app refunded the funds to the forwarder on this chain, from where they are recovered.
+			forwarderAddr, err := sdk.AccAddressFromBech32(data.GetSender())
+			if err != nil {
+				return err
+			}
+			recoverAddr, err := sdk.AccAddressFromBech32(inFlightPacket.RecoverAddress)
+			if err != nil {
+				return err
+			}
+			if err := tokens.send(ctx, forwarderAddr, recoverAddr); err != nil {
+				return fmt.Errorf("failed to send funds of failed forward to recover address: %w", err)
+			}
+			if err := k.returnForwardFee(ctx, inFlightPacket, recoverAddr); err != nil {
+				return err
+			}
+
+			if coin, ok := tokens.coin(); ok {
+				k.releaseForwardFlow(ctx, inFlightPacket, next, transfertypes.ParseDenomTrace(fullDenomPath).BaseDenom, coin.Amount)
+			}
+
+			return k.writeRecoveredAcknowledgement(ctx, chanCap, inFlightPacket, nextHop, data.GetDenom(), data.GetAmount(), ack)
+		}
+
 		// If this packet is non-refundable due to some action that took place between the initial ibc transfer and the forward
 		// we write a successful ack containing details on what happened regardless of ack error or timeout
 		if inFlightPacket.Nonrefundable {
+			// the fee goes back to the forwarder on this chain, which keeps the funds of the received packet.
+			forwarderAddr, err := sdk.AccAddressFromBech32(data.GetSender())
+			if err != nil {
+				return err
+			}
+			if err := k.returnForwardFee(ctx, inFlightPacket, forwarderAddr); err != nil {
+				return err
+			}
+
 			ackResult := fmt.Sprintf("packet forward failed after point of no return: %s", ack.GetError())
 			newAck := channeltypes.NewResultAcknowledgement([]byte(ackResult))
 
-			return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, channeltypes.Packet{
-				Data:               inFlightPacket.PacketData,
-				Sequence:           inFlightPacket.RefundSequence,
-				SourcePort:         inFlightPacket.PacketSrcPortId,
-				SourceChannel:      inFlightPacket.PacketSrcChannelId,
-				DestinationPort:    inFlightPacket.RefundPortId,
-				DestinationChannel: inFlightPacket.RefundChannelId,
-				TimeoutHeight:      clienttypes.MustParseHeight(inFlightPacket.PacketTimeoutHeight),
-				TimeoutTimestamp:   inFlightPacket.PacketTimeoutTimestamp,
-			}, newAck)
-		}
-
-		fullDenomPath := data.Denom
-
-		// deconstruct the token denomination into the denomination trace info
-		// to determine if the sender is the source chain
-		if strings.HasPrefix(data.Denom, "ibc/") {
-			fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, data.Denom)
-			if err != nil {
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardFailedNonrefundable{
+				OriginalPacket: originalPacket,
+				NextHop:        nextHop,
+				Denom:          data.GetDenom(),
+				Amount:         data.GetAmount(),
+				Error:          ack.GetError(),
+			}); err != nil {
 				return err
 			}
+
+			return k.writeOriginalPacketAcknowledgement(ctx, chanCap, inFlightPacket, newAck)
 		}
 
 		if transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, fullDenomPath) {
 			// funds were moved to escrow account for transfer, so they need to either:
 			// - move to the other escrow account, in the case of native denom
 			// - burn
+			escrowAddress := tokens.escrowAddress(packet.SourcePort, packet.SourceChannel)
 
-			amount, ok := sdk.NewIntFromString(data.Amount)
-			if !ok {
-				return fmt.Errorf("failed to parse amount from packet data for forward refund: %s", data.Amount)
+			if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
+				// transfer funds from escrow account for forwarded packet to escrow account going back for refund.
+				refundEscrowAddress := tokens.escrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+				if err := tokens.send(ctx, escrowAddress, refundEscrowAddress); err != nil {
+					return fmt.Errorf("failed to send funds from escrow account to refund escrow account: %w", err)
+				}
+			} else if err := tokens.burn(ctx, escrowAddress); err != nil {
+				return fmt.Errorf("failed to burn funds in escrow account: %w", err)
 			}
-			denomTrace := transfertypes.ParseDenomTrace(fullDenomPath)
-			token := sdk.NewCoin(denomTrace.IBCDenom(), amount)
+		}
 
-			escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+		if err := k.refundForwardFee(ctx, inFlightPacket, fullDenomPath); err != nil {
+			return err
+		}
 
-			if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
-				// transfer funds from escrow account for forwarded packet to escrow account going back for refund.
+		if coin, ok := tokens.coin(); ok {
+			k.releaseForwardFlow(ctx, inFlightPacket, next, transfertypes.ParseDenomTrace(fullDenomPath).BaseDenom, coin.Amount)
+		}
 
-				refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardRefunded{
+			OriginalPacket: originalPacket,
+			NextHop:        nextHop,
+			Denom:          data.GetDenom(),
+			Amount:         data.GetAmount(),
+			Error:          ack.GetError(),
+		}); err != nil {
+			return err
+		}
+	} else {
+		if err := k.collectForwardFee(ctx, inFlightPacket, data.GetSender()); err != nil {
+			return err
+		}
 
-				if err := k.bankKeeper.SendCoins(
-					ctx, escrowAddress, refundEscrowAddress, sdk.NewCoins(token),
-				); err != nil {
-					return fmt.Errorf("failed to send coins from escrow account to refund escrow account: %w", err)
-				}
-			} else {
-				// transfer the coins from the escrow account to the module account and burn them.
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardAcked{
+			OriginalPacket: originalPacket,
+			NextHop:        nextHop,
+			Denom:          data.GetDenom(),
+			Amount:         data.GetAmount(),
+		}); err != nil {
+			return err
+		}
+	}
 
-				if err := k.bankKeeper.SendCoinsFromAccountToModule(
-					ctx, escrowAddress, transfertypes.ModuleName, sdk.NewCoins(token),
-				); err != nil {
-					return fmt.Errorf("failed to send coins from escrow to module account for burn: %w", err)
-				}
+	return k.writeOriginalPacketAcknowledgement(ctx, chanCap, inFlightPacket, ack)
+}
 
-				if err := k.bankKeeper.BurnCoins(
-					ctx, transfertypes.ModuleName, sdk.NewCoins(token),
-				); err != nil {
-					// NOTE: should not happen as the module account was
-					// retrieved on the step above and it has enough balace
-					// to burn.
-					panic(fmt.Sprintf("cannot burn coins after a successful send from escrow account to module account: %v", err))
-				}
-			}
+// recoverForwardedFunds credits the funds of a failed forward, refunded to the forwarder on this chain by the
+// transfer application, to the recover address of the forward.
+func (k *Keeper) recoverForwardedFunds(ctx sdk.Context, forwarder, recoverAddress string, coins sdk.Coins) error {
+	forwarderAddr, err := sdk.AccAddressFromBech32(forwarder)
+	if err != nil {
+		return err
+	}
+	recoverAddr, err := sdk.AccAddressFromBech32(recoverAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, forwarderAddr, recoverAddr, coins); err != nil {
+		return fmt.Errorf("failed to send funds of failed forward to recover address: %w", err)
+	}
+	return nil
+}
+
+// refundForwardLocally refunds the funds of a forward over the given port and channel to the forwarder on this chain,
+// the way the transfer application refunds a failed forward: the funds are released from the escrow of the channel
+// if they were escrowed when forwarded, minted again otherwise.
+func (k *Keeper) refundForwardLocally(ctx sdk.Context, channel, port string, data types.ForwardPacketData) error {
+	tokens, err := k.packetTokens(data, data.GetDenom())
+	if err != nil {
+		return err
+	}
+	forwarder, err := sdk.AccAddressFromBech32(data.GetSender())
+	if err != nil {
+		return err
+	}
+	fullDenomPath, err := tokens.fullPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	if transfertypes.SenderChainIsSource(port, channel, fullDenomPath) {
+		if err := tokens.send(ctx, tokens.escrowAddress(port, channel), forwarder); err != nil {
+			return fmt.Errorf("failed to send funds of forward from escrow account to forwarder: %w", err)
 		}
+		return nil
+	}
+
+	if err := tokens.mint(ctx, forwarder); err != nil {
+		return fmt.Errorf("failed to mint funds of forward: %w", err)
+	}
+	return nil
+}
+
+// writeRecoveredAcknowledgement emits an EventForwardRecovered for a failed forward whose funds were credited to
+// its recover address and writes a successful acknowledgement for the original packet.
+func (k *Keeper) writeRecoveredAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	inFlightPacket *types.InFlightPacket,
+	nextHop *types.NextHopPacket,
+	denom, amount string,
+	ack channeltypes.Acknowledgement,
+) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardRecovered{
+		OriginalPacket: types.NewOriginalPacket(inFlightPacket),
+		NextHop:        nextHop,
+		Denom:          denom,
+		Amount:         amount,
+		RecoverAddress: inFlightPacket.RecoverAddress,
+		Error:          ack.GetError(),
+	}); err != nil {
+		return err
 	}
 
+	ackResult := fmt.Sprintf("packet forward failed, funds recovered to %s: %s", inFlightPacket.RecoverAddress, ack.GetError())
+	return k.writeOriginalPacketAcknowledgement(ctx, chanCap, inFlightPacket, channeltypes.NewResultAcknowledgement([]byte(ackResult)))
+}
+
+// writeOriginalPacketAcknowledgement writes the acknowledgement of the original packet an in-flight packet is
+// forwarding, back to the chain the original packet came from.
+func (k *Keeper) writeOriginalPacketAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	inFlightPacket *types.InFlightPacket,
+	ack ibcexported.Acknowledgement,
+) error {
 	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, channeltypes.Packet{
 		Data:               inFlightPacket.PacketData,
 		Sequence:           inFlightPacket.RefundSequence,
@@ -193,6 +355,9 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 	}, ack)
 }
 
+// ForwardTransferPacket forwards the tokens of data, the packet data of a received packet, to the next hop once they
+// are owned by receiver on this chain. denom is the denom of the tokens on this chain, or the class ID of NFTs.
+// inFlightPacket is nil for the first forward of a packet, and set when a timed out forward is retried.
 func (k *Keeper) ForwardTransferPacket(
 	ctx sdk.Context,
 	inFlightPacket *types.InFlightPacket,
@@ -200,31 +365,83 @@ func (k *Keeper) ForwardTransferPacket(
 	srcPacketSender string,
 	receiver string,
 	metadata *types.ForwardMetadata,
+	data types.ForwardPacketData,
+	denom string,
+	maxRetries uint8,
+	timeout time.Duration,
+	timeoutHeightOffset uint64,
+	labels []metrics.Label,
+	nonrefundable bool,
+) error {
+	tokens, err := k.packetTokens(data, denom)
+	if err != nil {
+		return err
+	}
+
+	retry := inFlightPacket != nil
+	if !retry {
+		inFlightPacket = newInFlightPacket(srcPacket, srcPacketSender, metadata, maxRetries, timeout, timeoutHeightOffset, nonrefundable)
+	}
+	return k.forwardTransferPacket(ctx, inFlightPacket, retry, receiver, metadata, tokens, timeout, labels)
+}
+
+// ForwardSplitTransferPacket forwards token, the share of a received packet allocated to one of the next hops
+// the packet is fanned out to. The acknowledgement of srcPacket is written once the split forward tracked with
+// SetSplitForward resolves.
+func (k *Keeper) ForwardSplitTransferPacket(
+	ctx sdk.Context,
+	srcPacket channeltypes.Packet,
+	srcPacketSender string,
+	receiver string,
+	metadata *types.ForwardMetadata,
 	token sdk.Coin,
 	maxRetries uint8,
 	timeout time.Duration,
+	timeoutHeightOffset uint64,
 	labels []metrics.Label,
 	nonrefundable bool,
 ) error {
-	var err error
-	feeAmount := sdk.NewDecFromInt(token.Amount).Mul(k.GetFeePercentage(ctx)).RoundInt()
-	packetAmount := token.Amount.Sub(feeAmount)
-	feeCoins := sdk.Coins{sdk.NewCoin(token.Denom, feeAmount)}
-	packetCoin := sdk.NewCoin(token.Denom, packetAmount)
+	inFlightPacket := newInFlightPacket(srcPacket, srcPacketSender, metadata, maxRetries, timeout, timeoutHeightOffset, nonrefundable)
+	inFlightPacket.Split = true
+	return k.forwardTransferPacket(ctx, inFlightPacket, false, receiver, metadata, k.coinTokens(token), timeout, labels)
+}
 
-	// pay fees
-	if feeAmount.IsPositive() {
-		hostAccAddr, err := sdk.AccAddressFromBech32(receiver)
-		if err != nil {
+// forwardTransferPacket sends tokens, owned by receiver on this chain, to the next hop and stores inFlightPacket
+// under the sequence of the sent packet.
+func (k *Keeper) forwardTransferPacket(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	retry bool,
+	receiver string,
+	metadata *types.ForwardMetadata,
+	tokens forwardTokens,
+	timeout time.Duration,
+	labels []metrics.Label,
+) error {
+	token, fungible := tokens.coin()
+
+	if len(inFlightPacket.ChannelCandidates) > 0 {
+		previous := types.NewPortChannel(metadata.Port, metadata.Channel)
+		if err := k.selectChannelCandidate(ctx, inFlightPacket, metadata, retry); err != nil {
 			return err
 		}
-		err = k.distrKeeper.FundCommunityPool(ctx, feeCoins, hostAccAddr)
+		// the outflow recorded for the forward moves along with it to the channel it fails over to.
+		if next := types.NewPortChannel(metadata.Port, metadata.Channel); fungible && retry && next != previous {
+			if err := k.moveForwardOutflow(ctx, inFlightPacket, previous, next, token.Denom, token.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	// only fungible tokens are charged a fee and rate limited.
+	feeAmount := sdk.ZeroInt()
+	if fungible {
+		packetCoin, fee, err := k.chargeForward(ctx, inFlightPacket, retry, receiver, metadata, token)
 		if err != nil {
-			k.Logger(ctx).Error("packetForwardMiddleware error funding community pool",
-				"error", err,
-			)
-			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+			return err
 		}
+		feeAmount = fee
+		tokens = k.coinTokens(packetCoin)
 	}
 
 	memo := ""
@@ -241,70 +458,79 @@ func (k *Keeper) ForwardTransferPacket(
 		memo = string(memoBz)
 	}
 
-	msgTransfer := transfertypes.NewMsgTransfer(
-		metadata.Port,
-		metadata.Channel,
-		packetCoin,
-		receiver,
-		metadata.Receiver,
-		DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(timeout.Nanoseconds()),
-		memo,
-	)
+	timeoutHeight, err := k.forwardTimeoutHeight(ctx, metadata.Port, metadata.Channel, inFlightPacket, retry)
+	if err != nil {
+		return err
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(timeout.Nanoseconds())
 
 	k.Logger(ctx).Debug("packetForwardMiddleware ForwardTransferPacket",
 		"port", metadata.Port, "channel", metadata.Channel,
 		"sender", receiver, "receiver", metadata.Receiver,
-		"amount", packetCoin.Amount.String(), "denom", packetCoin.Denom,
+		"amount", tokens.amount(), "denom", tokens.denom(),
 	)
 
 	// send tokens to destination
-	res, err := k.transferKeeper.Transfer(
-		sdk.WrapSDKContext(ctx),
-		msgTransfer,
-	)
+	sequence, err := tokens.transfer(ctx, metadata.Port, metadata.Channel, receiver, metadata.Receiver, timeoutHeight, timeoutTimestamp, memo)
 	if err != nil {
 		k.Logger(ctx).Error("packetForwardMiddleware ForwardTransferPacket error",
 			"port", metadata.Port, "channel", metadata.Channel,
 			"sender", receiver, "receiver", metadata.Receiver,
-			"amount", packetCoin.Amount.String(), "denom", packetCoin.Denom,
+			"amount", tokens.amount(), "denom", tokens.denom(),
 			"error", err,
 		)
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		return err
 	}
 
 	// Store the following information in keeper:
 	// key - information about forwarded packet: src_channel (parsedReceiver.Channel), src_port (parsedReceiver.Port), sequence
 	// value - information about original packet for refunding if necessary: retries, srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
 
-	if inFlightPacket == nil {
-		inFlightPacket = &types.InFlightPacket{
-			PacketData:            srcPacket.Data,
-			OriginalSenderAddress: srcPacketSender,
-			RefundChannelId:       srcPacket.DestinationChannel,
-			RefundPortId:          srcPacket.DestinationPort,
-			RefundSequence:        srcPacket.Sequence,
-			PacketSrcPortId:       srcPacket.SourcePort,
-			PacketSrcChannelId:    srcPacket.SourceChannel,
-
-			PacketTimeoutTimestamp: srcPacket.TimeoutTimestamp,
-			PacketTimeoutHeight:    srcPacket.TimeoutHeight.String(),
-
-			RetriesRemaining: int32(maxRetries),
-			Timeout:          uint64(timeout.Nanoseconds()),
-			Nonrefundable:    nonrefundable,
-		}
-	} else {
+	if retry {
 		inFlightPacket.RetriesRemaining--
+		inFlightPacket.RetryAttempts++
+		inFlightPacket.RetryTime = nil
 	}
 
-	key := types.RefundPacketKey(metadata.Channel, metadata.Port, res.Sequence)
-	store := ctx.KVStore(k.storeKey)
+	inFlightPacket.ForwardPacketData = tokens.packetData(tokens.denom(), receiver, metadata.Receiver, memo).GetBytes()
+	inFlightPacket.ForwardTimeoutHeight = timeoutHeight.String()
+	inFlightPacket.ForwardTimeoutTimestamp = timeoutTimestamp
+
+	key := types.RefundPacketKey(metadata.Channel, metadata.Port, sequence)
+	store := k.inFlightPacketStore(ctx)
 	bz := k.cdc.MustMarshal(inFlightPacket)
 	store.Set(key, bz)
 
+	originalPacket := types.NewOriginalPacket(inFlightPacket)
+	nextHop := types.NewNextHopPacket(metadata.Port, metadata.Channel, sequence)
+
+	if retry {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventForwardRetried{
+			OriginalPacket:   originalPacket,
+			NextHop:          nextHop,
+			Receiver:         metadata.Receiver,
+			Denom:            tokens.denom(),
+			Amount:           tokens.amount(),
+			RetriesRemaining: inFlightPacket.RetriesRemaining,
+		})
+	} else {
+		err = ctx.EventManager().EmitTypedEvent(&types.EventForwardInitiated{
+			OriginalPacket: originalPacket,
+			NextHop:        nextHop,
+			Receiver:       metadata.Receiver,
+			Denom:          tokens.denom(),
+			Amount:         tokens.amount(),
+			FeeAmount:      feeAmount.String(),
+			Retries:        inFlightPacket.RetriesRemaining,
+			Timeout:        inFlightPacket.Timeout,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
 	defer func() {
-		if token.Amount.IsInt64() {
+		if fungible && token.Amount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
 				[]string{"tx", "msg", "ibc", "transfer"},
 				float32(token.Amount.Int64()),
@@ -321,12 +547,155 @@ func (k *Keeper) ForwardTransferPacket(
 	return nil
 }
 
+// chargeForward charges the forward of token, owned by receiver on this chain, to the next hop: the forward fee is
+// held in escrow until the forward resolves, and the forward is recorded against the rate limits of its channels.
+// It returns the coin sent to the next hop and the fee. Retries are not charged again, and send the same amount.
+func (k *Keeper) chargeForward(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	retry bool,
+	receiver string,
+	metadata *types.ForwardMetadata,
+	token sdk.Coin,
+) (sdk.Coin, sdk.Int, error) {
+	// the fee is only charged when the packet is first forwarded, retries send the same amount again.
+	feeAmount := sdk.ZeroInt()
+	if !retry {
+		var err error
+		feeAmount, err = k.GetForwardFee(ctx, metadata.Port, metadata.Channel, token)
+		if err != nil {
+			return sdk.Coin{}, sdk.Int{}, err
+		}
+	}
+	if feeAmount.GTE(token.Amount) {
+		return sdk.Coin{}, sdk.Int{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "amount %s is not greater than forwarding fee %s", token.Amount, feeAmount)
+	}
+	packetAmount := token.Amount.Sub(feeAmount)
+	feeCoins := sdk.Coins{sdk.NewCoin(token.Denom, feeAmount)}
+	packetCoin := sdk.NewCoin(token.Denom, packetAmount)
+
+	// retries were already accounted for when the packet was first forwarded.
+	if !retry {
+		if err := k.recordForwardFlow(
+			ctx,
+			inFlightPacket,
+			types.NewPortChannel(metadata.Port, metadata.Channel),
+			token.Denom, token.Amount, packetAmount,
+		); err != nil {
+			return sdk.Coin{}, sdk.Int{}, err
+		}
+	}
+
+	// hold the fee in escrow until the forward resolves.
+	if feeAmount.IsPositive() {
+		hostAccAddr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return sdk.Coin{}, sdk.Int{}, err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, hostAccAddr, types.FeeEscrowAddress(), feeCoins); err != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error escrowing forward fee",
+				"error", err,
+			)
+			return sdk.Coin{}, sdk.Int{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+		inFlightPacket.Fee = feeCoins
+	}
+
+	return packetCoin, feeAmount, nil
+}
+
+// newInFlightPacket returns the in-flight packet tracking the first forward of a received packet.
+func newInFlightPacket(
+	srcPacket channeltypes.Packet,
+	srcPacketSender string,
+	metadata *types.ForwardMetadata,
+	maxRetries uint8,
+	timeout time.Duration,
+	timeoutHeightOffset uint64,
+	nonrefundable bool,
+) *types.InFlightPacket {
+	// a requested timeout height takes precedence over deriving one.
+	if metadata.TimeoutHeight != "" {
+		timeoutHeightOffset = 0
+	}
+
+	return &types.InFlightPacket{
+		PacketData:            srcPacket.Data,
+		OriginalSenderAddress: srcPacketSender,
+		RefundChannelId:       srcPacket.DestinationChannel,
+		RefundPortId:          srcPacket.DestinationPort,
+		RefundSequence:        srcPacket.Sequence,
+		PacketSrcPortId:       srcPacket.SourcePort,
+		PacketSrcChannelId:    srcPacket.SourceChannel,
+
+		PacketTimeoutTimestamp: srcPacket.TimeoutTimestamp,
+		PacketTimeoutHeight:    srcPacket.TimeoutHeight.String(),
+
+		RetriesRemaining: int32(maxRetries),
+		Timeout:          uint64(timeout.Nanoseconds()),
+		Nonrefundable:    nonrefundable,
+		RecoverAddress:   metadata.RecoverAddress,
+
+		TimeoutHeight:       metadata.TimeoutHeight,
+		TimeoutHeightOffset: timeoutHeightOffset,
+
+		ChannelCandidates: metadata.ChannelCandidates(),
+	}
+}
+
+// forwardTimeoutHeight returns the timeout height of a forward over the given channel: the timeout height
+// requested in the forward metadata, or the latest height of the next hop's client plus the timeout height
+// offset. A retry whose requested timeout height was already reached only times out on timestamp.
+func (k *Keeper) forwardTimeoutHeight(
+	ctx sdk.Context,
+	port, channel string,
+	inFlightPacket *types.InFlightPacket,
+	retry bool,
+) (clienttypes.Height, error) {
+	if inFlightPacket.TimeoutHeight != "" {
+		timeoutHeight, err := clienttypes.ParseHeight(inFlightPacket.TimeoutHeight)
+		if err != nil {
+			return clienttypes.Height{}, err
+		}
+		if !retry {
+			return timeoutHeight, nil
+		}
+		latestHeight, err := k.counterpartyLatestHeight(ctx, port, channel)
+		if err != nil {
+			return clienttypes.Height{}, err
+		}
+		if latestHeight.LT(timeoutHeight) {
+			return timeoutHeight, nil
+		}
+		return DefaultTransferPacketTimeoutHeight, nil
+	}
+
+	if inFlightPacket.TimeoutHeightOffset == 0 {
+		return DefaultTransferPacketTimeoutHeight, nil
+	}
+
+	latestHeight, err := k.counterpartyLatestHeight(ctx, port, channel)
+	if err != nil {
+		return clienttypes.Height{}, err
+	}
+	return clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+inFlightPacket.TimeoutHeightOffset), nil
+}
+
+// counterpartyLatestHeight returns the latest height of the client of the chain at the other end of a channel.
+func (k *Keeper) counterpartyLatestHeight(ctx sdk.Context, port, channel string) (ibcexported.Height, error) {
+	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, port, channel)
+	if err != nil {
+		return nil, err
+	}
+	return clientState.GetLatestHeight(), nil
+}
+
 // TimeoutShouldRetry returns inFlightPacket and no error if retry should be attempted. Error is returned if IBC refund should occur.
 func (k *Keeper) TimeoutShouldRetry(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 ) (*types.InFlightPacket, error) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.inFlightPacketStore(ctx)
 	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
 
 	if !store.Has(key) {
@@ -345,66 +714,105 @@ func (k *Keeper) TimeoutShouldRetry(
 			"refund-channel-id", inFlightPacket.RefundChannelId,
 			"refund-port-id", inFlightPacket.RefundPortId,
 		)
-		return &inFlightPacket, fmt.Errorf("giving up on packet on channel (%s) port (%s) after max retries",
+		err := fmt.Errorf("giving up on packet on channel (%s) port (%s) after max retries",
 			inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId)
+
+		// the amounts are informational only, so a packet that cannot be decoded still gives up with empty amounts.
+		var denom, amount string
+		if data, decodeErr := types.ParseForwardPacketData(packet.GetData()); decodeErr == nil {
+			denom, amount = data.GetDenom(), data.GetAmount()
+		}
+
+		if emitErr := ctx.EventManager().EmitTypedEvent(&types.EventForwardGaveUp{
+			OriginalPacket: types.NewOriginalPacket(&inFlightPacket),
+			NextHop:        types.NewNextHopPacket(packet.SourcePort, packet.SourceChannel, packet.Sequence),
+			Denom:          denom,
+			Amount:         amount,
+			Error:          err.Error(),
+		}); emitErr != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error emitting event", "error", emitErr)
+		}
+
+		return &inFlightPacket, err
 	}
 
 	return &inFlightPacket, nil
 }
 
+// ErrorAckShouldRetry returns whether a forward acknowledged with an error should be retried like a timed out
+// forward, which is the case if it has retries remaining and either failover channels, or an error matching a
+// retryable error acknowledgement.
+func (k *Keeper) ErrorAckShouldRetry(ctx sdk.Context, inFlightPacket *types.InFlightPacket, ack channeltypes.Acknowledgement) bool {
+	if ack.Success() || inFlightPacket.RetriesRemaining <= 0 {
+		return false
+	}
+	// a forward with failover channels fails over on any error.
+	if len(inFlightPacket.ChannelCandidates) > 1 {
+		return true
+	}
+	return k.GetParams(ctx).IsRetryableErrorAck(ack.GetError())
+}
+
+// RetryTimeout sends a timed out forward, or one acknowledged with a retryable error, whose funds were refunded to
+// the forwarder on this chain by the transfer application, to the next hop again. If the retry backoff delays the retry, the funds are held in the retry escrow
+// account and the retry is scheduled for a later block instead.
 func (k *Keeper) RetryTimeout(
 	ctx sdk.Context,
 	channel, port string,
-	data transfertypes.FungibleTokenPacketData,
+	sequence uint64,
+	data types.ForwardPacketData,
+	inFlightPacket *types.InFlightPacket,
+) error {
+	if delay := k.GetParams(ctx).RetryBackoff.Delay(inFlightPacket.RetryAttempts + 1); delay > 0 && len(inFlightPacket.ForwardPacketData) > 0 {
+		return k.scheduleRetry(ctx, channel, port, sequence, inFlightPacket, delay)
+	}
+
+	k.inFlightPacketStore(ctx).Delete(types.RefundPacketKey(channel, port, sequence))
+	return k.retryTimeout(ctx, channel, port, data, inFlightPacket)
+}
+
+// retryTimeout sends a timed out forward to the next hop again.
+func (k *Keeper) retryTimeout(
+	ctx sdk.Context,
+	channel, port string,
+	data types.ForwardPacketData,
 	inFlightPacket *types.InFlightPacket,
 ) error {
 	// send transfer again
 	metadata := &types.ForwardMetadata{
-		Receiver: data.Receiver,
+		Receiver: data.GetReceiver(),
 		Channel:  channel,
 		Port:     port,
 	}
 
-	if data.Memo != "" {
-		if err := json.Unmarshal([]byte(data.Memo), metadata.Next); err != nil {
+	if data.GetMemo() != "" {
+		next := &types.JSONObject{}
+		if err := json.Unmarshal([]byte(data.GetMemo()), next); err != nil {
 			return fmt.Errorf("error unmarshaling memo json: %w", err)
 		}
+		metadata.Next = next
 	}
 
-	amount, ok := sdk.NewIntFromString(data.Amount)
-	if !ok {
-		k.Logger(ctx).Error("packetForwardMiddleware error parsing amount from string for router retry on timeout",
-			"original-sender-address", inFlightPacket.OriginalSenderAddress,
-			"refund-channel-id", inFlightPacket.RefundChannelId,
-			"refund-port-id", inFlightPacket.RefundPortId,
-			"retries-remaining", inFlightPacket.RetriesRemaining,
-			"amount", data.Amount,
-		)
-		return fmt.Errorf("error parsing amount from string for router retry: %s", data.Amount)
-	}
-
-	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
-
-	token := sdk.NewCoin(denom, amount)
-
 	// srcPacket and srcPacketSender are empty because inFlightPacket is non-nil.
 	return k.ForwardTransferPacket(
 		ctx,
 		inFlightPacket,
 		channeltypes.Packet{},
 		"",
-		data.Sender,
+		data.GetSender(),
 		metadata,
-		token,
+		data,
+		data.GetDenom(),
 		uint8(inFlightPacket.RetriesRemaining),
-		time.Duration(inFlightPacket.Timeout)*time.Nanosecond,
+		k.GetParams(ctx).RetryBackoff.Timeout(time.Duration(inFlightPacket.Timeout), inFlightPacket.RetryAttempts+1),
+		inFlightPacket.TimeoutHeightOffset,
 		nil,
 		inFlightPacket.Nonrefundable,
 	)
 }
 
 func (k *Keeper) RemoveInFlightPacket(ctx sdk.Context, packet channeltypes.Packet) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.inFlightPacketStore(ctx)
 	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
 	if !store.Has(key) {
 		// not a forwarded packet, ignore.
@@ -415,6 +823,24 @@ func (k *Keeper) RemoveInFlightPacket(ctx sdk.Context, packet channeltypes.Packe
 	store.Delete(key)
 }
 
+// GetInFlightPacket returns the InFlightPacket stored for the forwarded packet with the given channel, port and sequence.
+func (k *Keeper) GetInFlightPacket(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+) (types.InFlightPacket, bool) {
+	store := k.inFlightPacketStore(ctx)
+	bz := store.Get(types.RefundPacketKey(channel, port, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+	return inFlightPacket, true
+}
+
 // GetAndClearInFlightPacket will fetch an InFlightPacket from the store, remove it if it exists, and return it.
 func (k *Keeper) GetAndClearInFlightPacket(
 	ctx sdk.Context,
@@ -422,7 +848,7 @@ func (k *Keeper) GetAndClearInFlightPacket(
 	port string,
 	sequence uint64,
 ) *types.InFlightPacket {
-	store := ctx.KVStore(k.storeKey)
+	store := k.inFlightPacketStore(ctx)
 	key := types.RefundPacketKey(channel, port, sequence)
 	if !store.Has(key) {
 		// this is either not a forwarded packet, or it is the final destination for the refund.
@@ -439,6 +865,214 @@ func (k *Keeper) GetAndClearInFlightPacket(
 	return &inFlightPacket
 }
 
+// ForceRefund refunds an in-flight packet without waiting for the acknowledgement or timeout of the forwarded packet.
+// An error acknowledgement is written for the original packet using the same refund logic as a failed forward, the
+// in-flight packet is cleared, and the forwarded packet is recorded so that a later acknowledgement or timeout from
+// the next hop does not cause the funds to be refunded a second time. Split forwards and forwards with a recover
+// address are first refunded to the forwarder on this chain, and then settled like any failed split or recovered
+// forward.
+//
+// The forwarded packet must no longer be deliverable to the next hop, see checkUndeliverable. In-flight packets
+// forwarded before the forwarded packet was recorded require its data and timeouts, which are verified against the
+// commitment of the forwarded packet; they are ignored otherwise.
+func (k *Keeper) ForceRefund(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+	packetData []byte,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, channel, port, sequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInFlightPacketNotFound, "channel (%s) port (%s) sequence (%d)", channel, port, sequence)
+	}
+
+	// forwards awaiting a scheduled retry already timed out, and their packet was cleared by the timeout.
+	if inFlightPacket.RetryTime == nil {
+		forwarded, err := k.forwardedPacket(ctx, channel, port, sequence, &inFlightPacket, packetData, timeoutHeight, timeoutTimestamp)
+		if err != nil {
+			return err
+		}
+		if err := k.checkUndeliverable(ctx, forwarded); err != nil {
+			return err
+		}
+		if len(inFlightPacket.ForwardPacketData) == 0 {
+			inFlightPacket.ForwardPacketData = forwarded.Data
+		}
+	}
+
+	data, err := types.ParseForwardPacketData(inFlightPacket.ForwardPacketData)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal forwarded packet data: %v", err)
+	}
+
+	if inFlightPacket.RetryTime != nil {
+		// the forward timed out and its funds are held until its scheduled retry, which the force refund cancels.
+		if inFlightPacket.RefundedLocally() {
+			if err := k.releaseRetryFunds(ctx, data); err != nil {
+				return err
+			}
+		} else if err := k.restoreRetryFunds(ctx, channel, port, data); err != nil {
+			return err
+		}
+		k.retryQueueStore(ctx).Delete(types.RetryQueueKey(*inFlightPacket.RetryTime, channel, port, sequence))
+		inFlightPacket.RetryTime = nil
+	} else if inFlightPacket.RefundedLocally() {
+		// these forwards are refunded to the forwarder on this chain by the transfer application before the funds
+		// are moved on, which the force refund does in its place.
+		if err := k.refundForwardLocally(ctx, channel, port, data); err != nil {
+			return err
+		}
+	}
+
+	packet := channeltypes.Packet{
+		Data:          inFlightPacket.ForwardPacketData,
+		Sequence:      sequence,
+		SourcePort:    port,
+		SourceChannel: channel,
+	}
+
+	// the forwarded packet data records the denom on this chain, while acknowledgements expect the full denom path.
+	if data, err = k.sentPacketData(ctx, data); err != nil {
+		return err
+	}
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForceRefunded)
+	if inFlightPacket.Split {
+		err = k.WriteAcknowledgementForForwardedSplitPacket(ctx, packet, data, &inFlightPacket, ack)
+	} else {
+		err = k.WriteAcknowledgementForForwardedPacket(ctx, packet, data, &inFlightPacket, ack)
+	}
+	if err != nil {
+		return err
+	}
+
+	key := types.RefundPacketKey(channel, port, sequence)
+	k.inFlightPacketStore(ctx).Delete(key)
+	k.forceRefundedPacketStore(ctx).Set(key, []byte{0x01})
+
+	k.Logger(ctx).Info("packetForwardMiddleware force refunded in-flight packet",
+		"channel", channel, "port", port, "sequence", sequence,
+		"original-sender-address", inFlightPacket.OriginalSenderAddress,
+		"refund-channel-id", inFlightPacket.RefundChannelId,
+		"refund-port-id", inFlightPacket.RefundPortId,
+	)
+
+	return nil
+}
+
+// forwardedPacket returns the packet forwarded to the next hop for an in-flight packet. In-flight packets that do
+// not record the timeouts of the forwarded packet were forwarded before they were recorded, and the given packet data
+// and timeouts are used instead once verified against the commitment of the forwarded packet.
+func (k *Keeper) forwardedPacket(
+	ctx sdk.Context,
+	channel string,
+	port string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+	packetData []byte,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (channeltypes.Packet, error) {
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    port,
+		SourceChannel: channel,
+	}
+
+	if inFlightPacket.ForwardTimeoutHeight != "" {
+		height, err := clienttypes.ParseHeight(inFlightPacket.ForwardTimeoutHeight)
+		if err != nil {
+			return packet, err
+		}
+		packet.Data = inFlightPacket.ForwardPacketData
+		packet.TimeoutHeight = height
+		packet.TimeoutTimestamp = inFlightPacket.ForwardTimeoutTimestamp
+		return packet, nil
+	}
+
+	if len(packetData) == 0 {
+		return packet, errorsmod.Wrapf(
+			types.ErrForwardedPacketInvalid,
+			"in-flight packet on channel (%s) port (%s) sequence (%d) does not record the forwarded packet, "+
+				"its packet data and timeouts must be provided",
+			channel, port, sequence,
+		)
+	}
+	packet.Data = packetData
+	packet.TimeoutHeight = timeoutHeight
+	packet.TimeoutTimestamp = timeoutTimestamp
+
+	commitment := k.channelKeeper.GetPacketCommitment(ctx, port, channel, sequence)
+	if !bytes.Equal(commitment, channeltypes.CommitPacket(k.cdc, packet)) {
+		return packet, errorsmod.Wrapf(
+			types.ErrForwardedPacketInvalid,
+			"channel (%s) port (%s) sequence (%d)", channel, port, sequence,
+		)
+	}
+	return packet, nil
+}
+
+// checkUndeliverable returns an error unless a forwarded packet can no longer be received by the next hop, because
+// its channel is closed or it timed out according to the state of the counterparty client of its channel. Refunding
+// a packet the next hop may still receive would credit its funds on both chains.
+func (k *Keeper) checkUndeliverable(ctx sdk.Context, packet channeltypes.Packet) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if found && channel.State == channeltypes.CLOSED {
+		return nil
+	}
+
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, packet.SourcePort, packet.SourceChannel)
+	if err != nil {
+		return err
+	}
+	latestHeight := clientState.GetLatestHeight()
+	if !packet.TimeoutHeight.IsZero() && latestHeight.GTE(packet.TimeoutHeight) {
+		return nil
+	}
+	if packet.TimeoutTimestamp != 0 {
+		consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, latestHeight)
+		if found && consensusState.GetTimestamp() >= packet.TimeoutTimestamp {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(
+		types.ErrForwardDeliverable,
+		"channel (%s) port (%s) sequence (%d) is open and the packet has not timed out",
+		packet.SourceChannel, packet.SourcePort, packet.Sequence,
+	)
+}
+
+// sentPacketData returns forwarded packet data as sent to the next hop: the forwarded packet data recorded on an
+// in-flight packet holds the denom, or class ID, on this chain, while the sent packet holds its full path.
+func (k *Keeper) sentPacketData(ctx sdk.Context, data types.ForwardPacketData) (types.ForwardPacketData, error) {
+	tokens, err := k.packetTokens(data, data.GetDenom())
+	if err != nil {
+		return nil, err
+	}
+	fullPath, err := tokens.fullPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tokens.packetData(fullPath, data.GetSender(), data.GetReceiver(), data.GetMemo()), nil
+}
+
+// ClearForceRefundedPacket removes the record of a forwarded packet that was refunded by ForceRefund.
+// It returns true if the packet was force refunded, in which case its acknowledgement or timeout must be ignored.
+func (k *Keeper) ClearForceRefundedPacket(ctx sdk.Context, packet channeltypes.Packet) bool {
+	store := k.forceRefundedPacketStore(ctx)
+	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if !store.Has(key) {
+		return false
+	}
+
+	store.Delete(key)
+	return true
+}
+
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
 func (k Keeper) SendPacket(
 	ctx sdk.Context,
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// CheckMemoSize returns an error if the memo of a received packet to forward exceeds the maximum memo size.
+func (k Keeper) CheckMemoSize(ctx sdk.Context, memo string) error {
+	maxSize := k.GetParams(ctx).MaxMemoSize
+	if maxSize > 0 && uint64(len(memo)) > maxSize {
+		return errorsmod.Wrapf(types.ErrForwardLimitExceeded, "memo size %d exceeds maximum %d bytes", len(memo), maxSize)
+	}
+	return nil
+}
+
+// CheckHopDepth returns an error if the route of the forward metadata of a received packet exceeds the maximum
+// number of forward hops, counting the hops of the forwards nested in next memos.
+func (k Keeper) CheckHopDepth(ctx sdk.Context, m *types.PacketMetadata) error {
+	maxHops := int(k.GetParams(ctx).MaxForwardHops)
+	if maxHops == 0 {
+		return nil
+	}
+	if depth := m.HopDepth(maxHops); depth > maxHops {
+		return errorsmod.Wrapf(types.ErrForwardLimitExceeded, "route exceeds maximum of %d forward hops", maxHops)
+	}
+	return nil
+}
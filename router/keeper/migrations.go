@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/exported"
+	v2 "github.com/strangelove-ventures/packet-forward-middleware/v7/router/migrations/v2"
+	v3 "github.com/strangelove-ventures/packet-forward-middleware/v7/router/migrations/v3"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper         *Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k *Keeper, ss exported.Subspace) Migrator {
+	return Migrator{
+		keeper:         k,
+		legacySubspace: ss,
+	}
+}
+
+// Migrate1to2 migrates the router module state from consensus version 1 to 2.
+// It moves in-flight packets under their own key prefix.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeKey)
+}
+
+// Migrate2to3 migrates the router module state from consensus version 2 to 3.
+// It moves the parameters managed by the legacy x/params subspace into the module store.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the router MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// UpdateParams updates the module parameters.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ForceRefund refunds an in-flight packet that is stuck on the next hop.
+func (ms msgServer) ForceRefund(goCtx context.Context, msg *types.MsgForceRefund) (*types.MsgForceRefundResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	var timeoutHeight clienttypes.Height
+	if msg.TimeoutHeight != "" {
+		var err error
+		if timeoutHeight, err = clienttypes.ParseHeight(msg.TimeoutHeight); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ForceRefund(
+		ctx, msg.ChannelId, msg.PortId, msg.Sequence, msg.PacketData, timeoutHeight, msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceRefundResponse{}, nil
+}
+
+// SetPaused pauses or resumes forwarding for a scope.
+func (ms msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.Scope.Validate(); err != nil {
+		return nil, err
+	}
+	ms.Keeper.SetPaused(ctx, msg.Scope, msg.Paused)
+
+	return &types.MsgSetPausedResponse{}, nil
+}
+
+// SetChainRoute adds or replaces a route of the chain registry.
+func (ms msgServer) SetChainRoute(goCtx context.Context, msg *types.MsgSetChainRoute) (*types.MsgSetChainRouteResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SetChainRoute(ctx, msg.Route); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetChainRouteResponse{}, nil
+}
+
+// RemoveChainRoute removes a route of the chain registry.
+func (ms msgServer) RemoveChainRoute(goCtx context.Context, msg *types.MsgRemoveChainRoute) (*types.MsgRemoveChainRouteResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.RemoveChainRoute(ctx, msg.ChainId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveChainRouteResponse{}, nil
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/keeper"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(sdk.NewDecWithPrec(5, 2))
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(testSender, params))
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(k.GetAuthority(), types.NewParams(sdk.NewDec(2))))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
+func TestMsgUpdateParams_FeeRecipient(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.FeeRecipient = types.FeeRecipient{Type: types.FeeRecipientTypeModuleAccount, ModuleName: "relayer_incentives"}
+
+	// fees cannot be paid to a module account that is not registered.
+	setup.Mocks.AccountKeeperMock.EXPECT().GetModuleAddress("relayer_incentives").Return(nil)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.Error(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	setup.Mocks.AccountKeeperMock.EXPECT().GetModuleAddress("relayer_incentives").Return(authtypes.NewModuleAddress("relayer_incentives"))
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// nor to the community pool on chains without a distribution keeper.
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	k = keeper.NewKeeper(
+		setup.Initializer.Marshaler, storeKey, nil, nil, nil, nil, setup.Mocks.AccountKeeperMock, nil, nil, k.GetAuthority(),
+	)
+	require.Error(t, k.SetParams(ctx, types.DefaultParams()))
+
+	params.FeeRecipient = types.FeeRecipient{Type: types.FeeRecipientTypeBurn}
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+func TestMsgSetPaused(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	scope := types.NewPauseScope("transfer", "channel-0", "")
+	received := types.NewPortChannel("transfer", "channel-1")
+
+	_, err := msgServer.SetPaused(goCtx, types.NewMsgSetPaused(testSender, scope, true))
+	require.Error(t, err)
+	require.False(t, k.IsPaused(ctx, scope))
+
+	_, err = msgServer.SetPaused(goCtx, types.NewMsgSetPaused(k.GetAuthority(), types.NewPauseScope("transfer", "channel-0", "uatom"), true))
+	require.Error(t, err)
+
+	_, err = msgServer.SetPaused(goCtx, types.NewMsgSetPaused(k.GetAuthority(), scope, true))
+	require.NoError(t, err)
+	require.True(t, k.IsPaused(ctx, scope))
+	require.ErrorIs(t, k.CheckPaused(ctx, received, types.NewPortChannel("transfer", "channel-0"), "uatom", "uatom"), types.ErrForwardingPaused)
+	require.NoError(t, k.CheckPaused(ctx, received, types.NewPortChannel("transfer", "channel-2"), "uatom", "uatom"))
+
+	res, err := k.Paused(goCtx, &types.QueryPausedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PauseScope{scope}, res.Paused)
+
+	_, err = msgServer.SetPaused(goCtx, types.NewMsgSetPaused(k.GetAuthority(), scope, false))
+	require.NoError(t, err)
+	require.False(t, k.IsPaused(ctx, scope))
+	require.NoError(t, k.CheckPaused(ctx, received, types.NewPortChannel("transfer", "channel-0"), "uatom", "uatom"))
+}
+
+func TestMsgSetChainRoute(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	k := setup.Keepers.RouterKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	route := types.ChainRoute{
+		ChainId: "osmosis-1", Port: "transfer", Channel: "channel-141",
+		Aliases: []string{"osmosis"}, Bech32Prefixes: []string{"osmo"},
+	}
+
+	_, err := msgServer.SetChainRoute(goCtx, types.NewMsgSetChainRoute(testSender, route))
+	require.Error(t, err)
+
+	_, err = msgServer.SetChainRoute(goCtx, types.NewMsgSetChainRoute(k.GetAuthority(), route))
+	require.NoError(t, err)
+
+	res, err := k.ChainRoute(goCtx, &types.QueryChainRouteRequest{Chain: "osmosis"})
+	require.NoError(t, err)
+	require.Equal(t, route, res.ChainRoute)
+	res, err = k.ChainRouteByBech32Prefix(goCtx, &types.QueryChainRouteByBech32PrefixRequest{Bech32Prefix: "osmo"})
+	require.NoError(t, err)
+	require.Equal(t, route, res.ChainRoute)
+
+	// an alias or bech32 prefix cannot name two chains.
+	conflicting := types.ChainRoute{ChainId: "osmo-test-5", Port: "transfer", Channel: "channel-0", Aliases: []string{"osmosis"}}
+	_, err = msgServer.SetChainRoute(goCtx, types.NewMsgSetChainRoute(k.GetAuthority(), conflicting))
+	require.ErrorIs(t, err, types.ErrChainRouteConflict)
+
+	// replacing a route drops its previous aliases.
+	route.Channel = "channel-142"
+	route.Aliases = []string{"osmo"}
+	_, err = msgServer.SetChainRoute(goCtx, types.NewMsgSetChainRoute(k.GetAuthority(), route))
+	require.NoError(t, err)
+	_, found := k.ResolveChain(ctx, "osmosis")
+	require.False(t, found)
+
+	// the receiver of a forward without a channel resolves the chain by its bech32 prefix.
+	receiver, err := bech32.ConvertAndEncode("osmo", test.AccAddress())
+	require.NoError(t, err)
+	metadata := &types.ForwardMetadata{Receiver: receiver}
+	require.NoError(t, k.ResolveForwardChain(ctx, metadata))
+	require.Equal(t, "channel-142", metadata.Channel)
+
+	metadata = &types.ForwardMetadata{Receiver: receiver, Chain: "unknown-1"}
+	require.ErrorIs(t, k.ResolveForwardChain(ctx, metadata), types.ErrUnknownChain)
+
+	routes, err := k.ChainRoutes(goCtx, &types.QueryChainRoutesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChainRoute{route}, routes.ChainRoutes)
+
+	_, err = msgServer.RemoveChainRoute(goCtx, types.NewMsgRemoveChainRoute(k.GetAuthority(), "osmosis-1"))
+	require.NoError(t, err)
+	_, err = k.ChainRoute(goCtx, &types.QueryChainRouteRequest{Chain: "osmosis-1"})
+	require.Error(t, err)
+	_, found = k.GetChainRouteByBech32Prefix(ctx, "osmo")
+	require.False(t, found)
+
+	_, err = msgServer.RemoveChainRoute(goCtx, types.NewMsgRemoveChainRoute(k.GetAuthority(), "osmosis-1"))
+	require.ErrorIs(t, err, types.ErrUnknownChain)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// nonFungibleTokens are the NFTs of an ICS-721 transfer. NFTs are moved by class and token ID, whichever account
+// holds them. Class IDs are traced the same way as ICS-20 denoms.
+type nonFungibleTokens struct {
+	k *Keeper
+	// path is the class ID the NFTs were given with, either on this chain or as its full path.
+	path     string
+	tokenIDs []string
+}
+
+func (t nonFungibleTokens) denom() string {
+	return transfertypes.ParseDenomTrace(t.path).IBCDenom()
+}
+
+func (t nonFungibleTokens) amount() string {
+	return strings.Join(t.tokenIDs, ",")
+}
+
+func (t nonFungibleTokens) coin() (sdk.Coin, bool) {
+	return sdk.Coin{}, false
+}
+
+func (t nonFungibleTokens) fullPath(ctx sdk.Context) (string, error) {
+	if strings.HasPrefix(t.path, "ibc/") {
+		return t.k.nftTransferKeeper.ClassPathFromHash(ctx, t.path)
+	}
+	return t.path, nil
+}
+
+func (t nonFungibleTokens) escrowAddress(port, channel string) sdk.AccAddress {
+	return t.k.nftTransferKeeper.GetEscrowAddress(port, channel)
+}
+
+func (t nonFungibleTokens) send(ctx sdk.Context, _, to sdk.AccAddress) error {
+	classID := t.denom()
+	for _, tokenID := range t.tokenIDs {
+		if err := t.k.nftKeeper.Transfer(ctx, classID, tokenID, to); err != nil {
+			return fmt.Errorf("failed to transfer NFT %s/%s: %w", classID, tokenID, err)
+		}
+	}
+	return nil
+}
+
+func (t nonFungibleTokens) burn(ctx sdk.Context, _ sdk.AccAddress) error {
+	classID := t.denom()
+	for _, tokenID := range t.tokenIDs {
+		if err := t.k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+			return fmt.Errorf("failed to burn NFT %s/%s: %w", classID, tokenID, err)
+		}
+	}
+	return nil
+}
+
+// mint always fails, as NFTs burned when sent over a channel cannot be minted again by this module.
+func (t nonFungibleTokens) mint(sdk.Context, sdk.AccAddress) error {
+	return errorsmod.Wrapf(
+		sdkerrors.ErrInvalidRequest, "NFTs of class %s were burned when forwarded and cannot be minted again on this chain", t.path,
+	)
+}
+
+func (t nonFungibleTokens) transfer(
+	ctx sdk.Context,
+	port, channel string,
+	sender, receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return 0, err
+	}
+	return t.k.nftTransferKeeper.SendTransfer(
+		ctx, port, channel, t.denom(), t.tokenIDs, senderAddr, receiver, timeoutHeight, timeoutTimestamp, memo,
+	)
+}
+
+func (t nonFungibleTokens) packetData(denom, sender, receiver, memo string) types.ForwardPacketData {
+	data := types.NewNonFungibleTokenPacketData(denom, "", "", t.tokenIDs, nil, nil, sender, receiver, memo)
+	return &data
+}
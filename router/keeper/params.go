@@ -5,19 +5,42 @@ import (
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
 )
 
-// GetSendEnabled retrieves the send enabled boolean from the paramstore
+// GetFeePercentage retrieves the fee percentage for forwarded packets from the module params
 func (k Keeper) GetFeePercentage(ctx sdk.Context) sdk.Dec {
-	var res sdk.Dec
-	k.paramSpace.Get(ctx, types.KeyFeePercentage, &res)
-	return res
+	return k.GetParams(ctx).FeePercentage
 }
 
-// GetParams returns the total set of ibc-transfer parameters.
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetFeePercentage(ctx))
+// CheckRoutingPolicy returns an error if forwarding a token received on source to the next hop destination is
+// not allowed by the routing policy param. baseDenom and denom are the base denom of the token and its denom on
+// this chain.
+func (k Keeper) CheckRoutingPolicy(ctx sdk.Context, source, destination types.PortChannel, baseDenom, denom string) error {
+	return k.GetParams(ctx).RoutingPolicy.Check(source, destination, baseDenom, denom)
 }
 
-// SetParams sets the total set of ibc-transfer parameters.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+// GetParams returns the total set of router parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of router parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.validateFeeRecipient(params.FeeRecipient); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
 }
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+func (k Keeper) pausedStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedKeyPrefix)
+}
+
+// SetPaused pauses or resumes forwarding for a scope.
+func (k Keeper) SetPaused(ctx sdk.Context, scope types.PauseScope, paused bool) {
+	store := k.pausedStore(ctx)
+	key := types.PausedKey(scope)
+	if !paused {
+		store.Delete(key)
+	} else {
+		store.Set(key, k.cdc.MustMarshal(&scope))
+	}
+
+	k.Logger(ctx).Info("packetForwardMiddleware forwarding pause updated",
+		"scope", scope.Description(),
+		"paused", paused,
+	)
+}
+
+// IsPaused returns whether forwarding is paused for exactly the given scope.
+func (k Keeper) IsPaused(ctx sdk.Context, scope types.PauseScope) bool {
+	return k.pausedStore(ctx).Has(types.PausedKey(scope))
+}
+
+// GetAllPaused returns all scopes forwarding is paused for.
+func (k Keeper) GetAllPaused(ctx sdk.Context) []types.PauseScope {
+	var scopes []types.PauseScope
+
+	itr := k.pausedStore(ctx).Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var scope types.PauseScope
+		k.cdc.MustUnmarshal(itr.Value(), &scope)
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+// CheckPaused returns an error if forwarding is paused for a token received on the channel received and sent to
+// the next hop channel next. baseDenom and denom are the base denom of the token and its denom on this chain.
+func (k Keeper) CheckPaused(ctx sdk.Context, received, next types.PortChannel, baseDenom, denom string) error {
+	scopes := []types.PauseScope{
+		types.NewPauseScope("", "", ""),
+		types.NewPauseScope(received.Port, received.Channel, ""),
+		types.NewPauseScope(next.Port, next.Channel, ""),
+		types.NewPauseScope("", "", baseDenom),
+		types.NewPauseScope("", "", denom),
+	}
+	for _, scope := range scopes {
+		if k.IsPaused(ctx, scope) {
+			return errorsmod.Wrapf(types.ErrForwardingPaused, "forwarding is paused for %s", scope.Description())
+		}
+	}
+	return nil
+}
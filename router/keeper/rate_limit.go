@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+func (k Keeper) rateLimitFlowStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitFlowKeyPrefix)
+}
+
+// GetRateLimitFlow returns the flow recorded for a rate limit in its window ending in the block in ctx. Buckets the
+// window has rolled past are dropped.
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitFlow {
+	var flow types.RateLimitFlow
+	bz := k.rateLimitFlowStore(ctx).Get(types.RateLimitFlowKey(rateLimit.Port, rateLimit.Channel, rateLimit.Denom))
+	if bz == nil {
+		return flow
+	}
+
+	k.cdc.MustUnmarshal(bz, &flow)
+	flow.Prune(rateLimit, rateLimit.Bucket(ctx.BlockHeight(), ctx.BlockTime()))
+	return flow
+}
+
+func (k Keeper) setRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit, flow types.RateLimitFlow) {
+	bz := k.cdc.MustMarshal(&flow)
+	k.rateLimitFlowStore(ctx).Set(types.RateLimitFlowKey(rateLimit.Port, rateLimit.Channel, rateLimit.Denom), bz)
+}
+
+// addRateLimitFlow records inflow and outflow for a rate limit in the bucket of the block in ctx. An error is returned
+// if the flow within the window exceeds the quota of the rate limit, in which case nothing is recorded.
+func (k Keeper) addRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit, inflow, outflow sdk.Int) error {
+	flow := k.GetRateLimitFlow(ctx, rateLimit)
+	flow.Add(rateLimit.Bucket(ctx.BlockHeight(), ctx.BlockTime()), inflow, outflow)
+
+	channel := types.NewPortChannel(rateLimit.Port, rateLimit.Channel)
+	if maxInflow := rateLimit.GetMaxInflow(); inflow.IsPositive() && maxInflow.IsPositive() && flow.Inflow().GT(maxInflow) {
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded, "inflow of %s on channel %s would be %s, above the quota of %s",
+			rateLimit.Denom, channel, flow.Inflow(), maxInflow,
+		)
+	}
+	if maxOutflow := rateLimit.GetMaxOutflow(); outflow.IsPositive() && maxOutflow.IsPositive() && flow.Outflow().GT(maxOutflow) {
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded, "outflow of %s on channel %s would be %s, above the quota of %s",
+			rateLimit.Denom, channel, flow.Outflow(), maxOutflow,
+		)
+	}
+
+	k.setRateLimitFlow(ctx, rateLimit, flow)
+	return nil
+}
+
+// releaseRateLimitFlow releases inflow and outflow recorded for a rate limit in the bucket of the given block, if
+// the window has not rolled past it yet.
+func (k Keeper) releaseRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit, block *types.FlowBlock, inflow, outflow sdk.Int) {
+	flow := k.GetRateLimitFlow(ctx, rateLimit)
+	flow.Release(rateLimit.Bucket(block.Height, block.Time), inflow, outflow)
+	k.setRateLimitFlow(ctx, rateLimit, flow)
+}
+
+// recordForwardFlow records the inflow of a forward on the channel it was received on and its outflow on the
+// next hop channel, and the blocks they were recorded in on the in-flight packet. An error is returned if either
+// exceeds the quota of its rate limit, in which case the forward must not proceed. denom is the denom of the
+// forwarded token on this chain.
+func (k Keeper) recordForwardFlow(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	next types.PortChannel,
+	denom string,
+	inflow, outflow sdk.Int,
+) error {
+	params := k.GetParams(ctx)
+	if len(params.RateLimits) == 0 {
+		return nil
+	}
+
+	baseDenom, err := k.baseDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	received := types.NewPortChannel(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	return k.recordBaseDenomFlow(ctx, params, inFlightPacket, received, next, baseDenom, inflow, outflow)
+}
+
+// recordBaseDenomFlow records the inflow and outflow of a forward of a token with the given base denom, see
+// recordForwardFlow.
+func (k Keeper) recordBaseDenomFlow(
+	ctx sdk.Context,
+	params types.Params,
+	inFlightPacket *types.InFlightPacket,
+	received, next types.PortChannel,
+	baseDenom string,
+	inflow, outflow sdk.Int,
+) error {
+	if rateLimit, found := params.RateLimit(received.Port, received.Channel, baseDenom); found {
+		if err := k.addRateLimitFlow(ctx, rateLimit, inflow, sdk.ZeroInt()); err != nil {
+			return err
+		}
+		inFlightPacket.InflowBlock = types.NewFlowBlock(ctx)
+	}
+
+	if rateLimit, found := params.RateLimit(next.Port, next.Channel, baseDenom); found {
+		if err := k.addRateLimitFlow(ctx, rateLimit, sdk.ZeroInt(), outflow); err != nil {
+			return err
+		}
+		inFlightPacket.OutflowBlock = types.NewFlowBlock(ctx)
+	}
+
+	return nil
+}
+
+// releaseForwardFlow releases the quota used by a forward of amount over the next hop channel that was refunded.
+// Like recordForwardFlow, the inflow includes the fee of the forward while the outflow does not. The quota is only
+// released in the buckets the forward was recorded in, so a refund after the window rolled past them releases
+// nothing.
+func (k Keeper) releaseForwardFlow(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	next types.PortChannel,
+	baseDenom string,
+	amount sdk.Int,
+) {
+	params := k.GetParams(ctx)
+
+	if block := inFlightPacket.InflowBlock; block != nil {
+		inflow := amount
+		for _, fee := range inFlightPacket.Fee {
+			inflow = inflow.Add(fee.Amount)
+		}
+		if rateLimit, found := params.RateLimit(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, baseDenom); found {
+			k.releaseRateLimitFlow(ctx, rateLimit, block, inflow, sdk.ZeroInt())
+		}
+	}
+
+	if block := inFlightPacket.OutflowBlock; block != nil {
+		if rateLimit, found := params.RateLimit(next.Port, next.Channel, baseDenom); found {
+			k.releaseRateLimitFlow(ctx, rateLimit, block, sdk.ZeroInt(), amount)
+		}
+	}
+}
+
+// moveForwardOutflow moves the outflow of a forward that fails over from one next hop channel to another, where it
+// is recorded in the current block. An error is returned if the outflow exceeds the quota of the channel it moves
+// to, in which case the forward must not fail over. denom is the denom of the forwarded token on this chain.
+func (k Keeper) moveForwardOutflow(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	from, to types.PortChannel,
+	denom string,
+	amount sdk.Int,
+) error {
+	params := k.GetParams(ctx)
+	if len(params.RateLimits) == 0 {
+		inFlightPacket.OutflowBlock = nil
+		return nil
+	}
+
+	baseDenom, err := k.baseDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	var outflowBlock *types.FlowBlock
+	if rateLimit, found := params.RateLimit(to.Port, to.Channel, baseDenom); found {
+		if err := k.addRateLimitFlow(ctx, rateLimit, sdk.ZeroInt(), amount); err != nil {
+			return err
+		}
+		outflowBlock = types.NewFlowBlock(ctx)
+	}
+
+	if block := inFlightPacket.OutflowBlock; block != nil {
+		if rateLimit, found := params.RateLimit(from.Port, from.Channel, baseDenom); found {
+			k.releaseRateLimitFlow(ctx, rateLimit, block, sdk.ZeroInt(), amount)
+		}
+	}
+
+	inFlightPacket.OutflowBlock = outflowBlock
+	return nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+func (k Keeper) chainRouteStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainRouteKeyPrefix)
+}
+
+func (k Keeper) chainAliasStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ChainAliasKeyPrefix)
+}
+
+func (k Keeper) bech32PrefixStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.Bech32PrefixKeyPrefix)
+}
+
+// SetChainRoute adds a route to the chain registry, replacing the route with the same chain ID. An error is returned
+// if an alias or bech32 prefix of the route already belongs to another chain.
+func (k Keeper) SetChainRoute(ctx sdk.Context, route types.ChainRoute) error {
+	if err := route.Validate(); err != nil {
+		return err
+	}
+
+	for _, name := range route.Names() {
+		if existing, found := k.ResolveChain(ctx, name); found && existing.ChainId != route.ChainId {
+			return errorsmod.Wrapf(types.ErrChainRouteConflict, "%s already names chain %s", name, existing.ChainId)
+		}
+	}
+	for _, bech32Prefix := range route.Bech32Prefixes {
+		if existing, found := k.GetChainRouteByBech32Prefix(ctx, bech32Prefix); found && existing.ChainId != route.ChainId {
+			return errorsmod.Wrapf(
+				types.ErrChainRouteConflict, "bech32 prefix %s already belongs to chain %s", bech32Prefix, existing.ChainId,
+			)
+		}
+	}
+
+	k.deleteChainRoute(ctx, route.ChainId)
+
+	k.chainRouteStore(ctx).Set([]byte(route.ChainId), k.cdc.MustMarshal(&route))
+	for _, alias := range route.Aliases {
+		k.chainAliasStore(ctx).Set([]byte(alias), []byte(route.ChainId))
+	}
+	for _, bech32Prefix := range route.Bech32Prefixes {
+		k.bech32PrefixStore(ctx).Set([]byte(bech32Prefix), []byte(route.ChainId))
+	}
+
+	k.Logger(ctx).Info("packetForwardMiddleware chain route set",
+		"chain-id", route.ChainId,
+		"port", route.Port, "channel", route.Channel,
+	)
+	return nil
+}
+
+// RemoveChainRoute removes the route of a chain from the chain registry.
+func (k Keeper) RemoveChainRoute(ctx sdk.Context, chainID string) error {
+	if !k.deleteChainRoute(ctx, chainID) {
+		return errorsmod.Wrapf(types.ErrUnknownChain, "chain %s", chainID)
+	}
+
+	k.Logger(ctx).Info("packetForwardMiddleware chain route removed", "chain-id", chainID)
+	return nil
+}
+
+// deleteChainRoute deletes the route of a chain along with its aliases and bech32 prefixes, returning whether it
+// existed.
+func (k Keeper) deleteChainRoute(ctx sdk.Context, chainID string) bool {
+	route, found := k.GetChainRoute(ctx, chainID)
+	if !found {
+		return false
+	}
+
+	k.chainRouteStore(ctx).Delete([]byte(chainID))
+	for _, alias := range route.Aliases {
+		k.chainAliasStore(ctx).Delete([]byte(alias))
+	}
+	for _, bech32Prefix := range route.Bech32Prefixes {
+		k.bech32PrefixStore(ctx).Delete([]byte(bech32Prefix))
+	}
+	return true
+}
+
+// GetChainRoute returns the route of the chain registry for a chain ID.
+func (k Keeper) GetChainRoute(ctx sdk.Context, chainID string) (types.ChainRoute, bool) {
+	bz := k.chainRouteStore(ctx).Get([]byte(chainID))
+	if bz == nil {
+		return types.ChainRoute{}, false
+	}
+
+	var route types.ChainRoute
+	k.cdc.MustUnmarshal(bz, &route)
+	return route, true
+}
+
+// GetAllChainRoutes returns all routes of the chain registry.
+func (k Keeper) GetAllChainRoutes(ctx sdk.Context) []types.ChainRoute {
+	var routes []types.ChainRoute
+
+	itr := k.chainRouteStore(ctx).Iterator(nil, nil)
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		var route types.ChainRoute
+		k.cdc.MustUnmarshal(itr.Value(), &route)
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// ResolveChain returns the route of the chain registry for a chain ID or alias.
+func (k Keeper) ResolveChain(ctx sdk.Context, chain string) (types.ChainRoute, bool) {
+	if route, found := k.GetChainRoute(ctx, chain); found {
+		return route, true
+	}
+	chainID := k.chainAliasStore(ctx).Get([]byte(chain))
+	if chainID == nil {
+		return types.ChainRoute{}, false
+	}
+	return k.GetChainRoute(ctx, string(chainID))
+}
+
+// GetChainRouteByBech32Prefix returns the route of the chain registry for the chain with a bech32 prefix.
+func (k Keeper) GetChainRouteByBech32Prefix(ctx sdk.Context, bech32Prefix string) (types.ChainRoute, bool) {
+	chainID := k.bech32PrefixStore(ctx).Get([]byte(bech32Prefix))
+	if chainID == nil {
+		return types.ChainRoute{}, false
+	}
+	return k.GetChainRoute(ctx, string(chainID))
+}
+
+// ResolveForwardChains resolves the chain of the forward, or of the forward of each split, of the metadata of a
+// received packet. It must be called before the metadata is validated.
+func (k Keeper) ResolveForwardChains(ctx sdk.Context, m *types.PacketMetadata) error {
+	if m.Forward != nil {
+		return k.ResolveForwardChain(ctx, m.Forward)
+	}
+	for _, split := range m.Splits {
+		if split == nil {
+			continue
+		}
+		if err := k.ResolveForwardChain(ctx, &split.ForwardMetadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResolveForwardChain sets the port and channel of a forward that targets a chain of the registry. A forward
+// without a chain, port, channel, path or unwind targets the chain of the bech32 prefix of its receiver if
+// registered. An error is returned if the chain is unknown or the bech32 prefix of the receiver belongs to another
+// chain.
+func (k Keeper) ResolveForwardChain(ctx sdk.Context, metadata *types.ForwardMetadata) error {
+	receiverPrefix, _, err := bech32.DecodeAndConvert(metadata.Receiver)
+	if err != nil {
+		receiverPrefix = ""
+	}
+
+	var route types.ChainRoute
+	switch {
+	case metadata.Chain != "":
+		if metadata.Port != "" || metadata.Channel != "" {
+			return errorsmod.Wrapf(types.ErrChainRouteConflict, "chain %s cannot be combined with port or channel", metadata.Chain)
+		}
+		var found bool
+		if route, found = k.ResolveChain(ctx, metadata.Chain); !found {
+			return errorsmod.Wrapf(types.ErrUnknownChain, "chain %s", metadata.Chain)
+		}
+	case metadata.Port == "" && metadata.Channel == "" && len(metadata.Path) == 0 && !metadata.Unwind && receiverPrefix != "":
+		var found bool
+		if route, found = k.GetChainRouteByBech32Prefix(ctx, receiverPrefix); !found {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	if receiverPrefix != "" {
+		if receiverRoute, found := k.GetChainRouteByBech32Prefix(ctx, receiverPrefix); found && receiverRoute.ChainId != route.ChainId {
+			return errorsmod.Wrapf(
+				types.ErrChainRouteConflict, "receiver %s is an address of chain %s, not chain %s",
+				metadata.Receiver, receiverRoute.ChainId, route.ChainId,
+			)
+		}
+	}
+
+	metadata.Chain = route.ChainId
+	metadata.Port = route.Port
+	metadata.Channel = route.Channel
+	return nil
+}
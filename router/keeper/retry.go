@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+func (k *Keeper) retryQueueStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RetryQueueKeyPrefix)
+}
+
+// scheduleRetry holds the funds of a timed out forward, refunded to the forwarder on this chain, in the retry escrow
+// account and schedules the forward to be sent to the next hop again once delay elapsed. The in-flight packet stays
+// stored under the timed out packet until then.
+func (k *Keeper) scheduleRetry(
+	ctx sdk.Context,
+	channel, port string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+	delay time.Duration,
+) error {
+	data, err := types.ParseForwardPacketData(inFlightPacket.ForwardPacketData)
+	if err != nil {
+		return err
+	}
+	if err := k.holdRetryFunds(ctx, data); err != nil {
+		return err
+	}
+
+	retryTime := ctx.BlockTime().Add(delay)
+	inFlightPacket.RetryTime = &retryTime
+
+	k.inFlightPacketStore(ctx).Set(types.RefundPacketKey(channel, port, sequence), k.cdc.MustMarshal(inFlightPacket))
+	k.retryQueueStore(ctx).Set(types.RetryQueueKey(retryTime, channel, port, sequence), []byte{0x01})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventForwardRetryScheduled{
+		OriginalPacket:   types.NewOriginalPacket(inFlightPacket),
+		NextHop:          types.NewNextHopPacket(port, channel, sequence),
+		Denom:            data.GetDenom(),
+		Amount:           data.GetAmount(),
+		RetryTime:        retryTime,
+		RetriesRemaining: inFlightPacket.RetriesRemaining,
+	})
+}
+
+// ProcessScheduledRetries sends the retries of timed out forwards that are due by the time of the current block.
+// A retry that cannot be sent gives up on the forward, the same way as a forward that ran out of retries. At most
+// the maximum number of retries per block of the retry backoff are sent, and the others are left for the next blocks.
+func (k *Keeper) ProcessScheduledRetries(ctx sdk.Context) {
+	store := k.retryQueueStore(ctx)
+
+	// the queue is ordered by retry time, so the retries due the longest are sent first when the maximum is reached.
+	maxRetries := int(k.GetParams(ctx).RetryBackoff.MaxRetriesPerBlock)
+
+	var keys [][]byte
+	itr := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	for ; itr.Valid() && (maxRetries == 0 || len(keys) < maxRetries); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		channel, port, sequence, err := types.ParseRetryQueueKey(key)
+		if err != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error parsing retry queue key", "key", string(key), "error", err)
+			continue
+		}
+
+		inFlightPacket, found := k.GetInFlightPacket(ctx, channel, port, sequence)
+		if !found || inFlightPacket.RetryTime == nil {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = k.sendScheduledRetry(cacheCtx, channel, port, sequence, &inFlightPacket)
+		if err == nil {
+			writeCache()
+			continue
+		}
+
+		k.Logger(ctx).Error("packetForwardMiddleware error sending scheduled retry",
+			"channel", channel, "port", port, "sequence", sequence,
+			"original-sender-address", inFlightPacket.OriginalSenderAddress,
+			"refund-channel-id", inFlightPacket.RefundChannelId,
+			"refund-port-id", inFlightPacket.RefundPortId,
+			"error", err,
+		)
+
+		// the in-flight packet is read again, as the failed retry may have modified it.
+		inFlightPacket, _ = k.GetInFlightPacket(ctx, channel, port, sequence)
+		cacheCtx, writeCache = ctx.CacheContext()
+		if err := k.giveUpScheduledRetry(cacheCtx, channel, port, sequence, &inFlightPacket, err); err != nil {
+			// the funds stay in the retry escrow account until the authority force refunds the in-flight packet.
+			k.Logger(ctx).Error("packetForwardMiddleware error giving up on scheduled retry",
+				"channel", channel, "port", port, "sequence", sequence,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// sendScheduledRetry releases the held funds of a timed out forward to the forwarder and sends the forward again.
+func (k *Keeper) sendScheduledRetry(
+	ctx sdk.Context,
+	channel, port string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+) error {
+	data, err := types.ParseForwardPacketData(inFlightPacket.ForwardPacketData)
+	if err != nil {
+		return err
+	}
+	if err := k.releaseRetryFunds(ctx, data); err != nil {
+		return err
+	}
+
+	k.inFlightPacketStore(ctx).Delete(types.RefundPacketKey(channel, port, sequence))
+	return k.retryTimeout(ctx, channel, port, data, inFlightPacket)
+}
+
+// giveUpScheduledRetry gives up on a timed out forward whose scheduled retry could not be sent, and writes an
+// error acknowledgement for the original packet through the same refund logic as a failed forward.
+func (k *Keeper) giveUpScheduledRetry(
+	ctx sdk.Context,
+	channel, port string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+	cause error,
+) error {
+	k.inFlightPacketStore(ctx).Delete(types.RefundPacketKey(channel, port, sequence))
+
+	data, err := types.ParseForwardPacketData(inFlightPacket.ForwardPacketData)
+	if err != nil {
+		return err
+	}
+
+	// the funds are returned to where a failed forward is refunded from.
+	if inFlightPacket.RefundedLocally() {
+		if err := k.releaseRetryFunds(ctx, data); err != nil {
+			return err
+		}
+	} else if err := k.restoreRetryFunds(ctx, channel, port, data); err != nil {
+		return err
+	}
+	inFlightPacket.RetryTime = nil
+
+	packet := channeltypes.Packet{
+		Data:          inFlightPacket.ForwardPacketData,
+		Sequence:      sequence,
+		SourcePort:    port,
+		SourceChannel: channel,
+	}
+	gaveUpErr := fmt.Errorf("giving up on packet on channel (%s) port (%s) after failed retry: %s",
+		inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, cause)
+	ack := channeltypes.NewErrorAcknowledgement(gaveUpErr)
+
+	// the forwarded packet data records the denom on this chain, while acknowledgements expect the full denom path.
+	if data, err = k.sentPacketData(ctx, data); err != nil {
+		return err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardGaveUp{
+		OriginalPacket: types.NewOriginalPacket(inFlightPacket),
+		NextHop:        types.NewNextHopPacket(packet.SourcePort, packet.SourceChannel, packet.Sequence),
+		Denom:          data.GetDenom(),
+		Amount:         data.GetAmount(),
+		Error:          gaveUpErr.Error(),
+	}); err != nil {
+		return err
+	}
+	if inFlightPacket.Split {
+		return k.WriteAcknowledgementForForwardedSplitPacket(ctx, packet, data, inFlightPacket, ack)
+	}
+	return k.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
+}
+
+// holdRetryFunds moves the funds of a timed out forward from the forwarder to the retry escrow account.
+func (k *Keeper) holdRetryFunds(ctx sdk.Context, data types.ForwardPacketData) error {
+	tokens, err := k.packetTokens(data, data.GetDenom())
+	if err != nil {
+		return err
+	}
+	forwarder, err := sdk.AccAddressFromBech32(data.GetSender())
+	if err != nil {
+		return err
+	}
+	if err := tokens.send(ctx, forwarder, types.RetryEscrowAddress()); err != nil {
+		return fmt.Errorf("failed to hold funds of timed out forward: %w", err)
+	}
+	return nil
+}
+
+// releaseRetryFunds moves the funds of a timed out forward held in the retry escrow account back to the forwarder.
+func (k *Keeper) releaseRetryFunds(ctx sdk.Context, data types.ForwardPacketData) error {
+	tokens, err := k.packetTokens(data, data.GetDenom())
+	if err != nil {
+		return err
+	}
+	forwarder, err := sdk.AccAddressFromBech32(data.GetSender())
+	if err != nil {
+		return err
+	}
+	if err := tokens.send(ctx, types.RetryEscrowAddress(), forwarder); err != nil {
+		return fmt.Errorf("failed to release held funds of timed out forward: %w", err)
+	}
+	return nil
+}
+
+// restoreRetryFunds moves the funds of a timed out forward held in the retry escrow account to where they were while
+// the forwarded packet was in flight: to the escrow of the next hop's channel if they did not originate from the next
+// hop, burned otherwise. The forward can then be refunded as if it never timed out.
+func (k *Keeper) restoreRetryFunds(ctx sdk.Context, channel, port string, data types.ForwardPacketData) error {
+	tokens, err := k.packetTokens(data, data.GetDenom())
+	if err != nil {
+		return err
+	}
+	fullDenomPath, err := tokens.fullPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	if transfertypes.SenderChainIsSource(port, channel, fullDenomPath) {
+		if err := tokens.send(ctx, types.RetryEscrowAddress(), tokens.escrowAddress(port, channel)); err != nil {
+			return fmt.Errorf("failed to send held funds of timed out forward to escrow account: %w", err)
+		}
+		return nil
+	}
+
+	if err := tokens.burn(ctx, types.RetryEscrowAddress()); err != nil {
+		return fmt.Errorf("failed to burn held funds of timed out forward: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// splitForwardStore returns the store holding received packets fanned out to several next hops, keyed by
+// RefundPacketKey of the received packet.
+func (k *Keeper) splitForwardStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SplitForwardKeyPrefix)
+}
+
+// SetSplitForward stores the tracking state of a received packet fanned out to several next hops.
+func (k *Keeper) SetSplitForward(ctx sdk.Context, channel, port string, sequence uint64, splitForward types.SplitForward) {
+	k.splitForwardStore(ctx).Set(types.RefundPacketKey(channel, port, sequence), k.cdc.MustMarshal(&splitForward))
+}
+
+// GetSplitForward returns the tracking state of the received packet with the given channel, port and sequence
+// on this chain.
+func (k *Keeper) GetSplitForward(ctx sdk.Context, channel, port string, sequence uint64) (types.SplitForward, bool) {
+	bz := k.splitForwardStore(ctx).Get(types.RefundPacketKey(channel, port, sequence))
+	if bz == nil {
+		return types.SplitForward{}, false
+	}
+
+	var splitForward types.SplitForward
+	k.cdc.MustUnmarshal(bz, &splitForward)
+	return splitForward, true
+}
+
+// WriteAcknowledgementForForwardedSplitPacket records the resolution of one of the next hops a received packet
+// was fanned out to. The funds of a failed split must already have been refunded to the receiver on this chain
+// by the transfer application, and are credited to the recover address of the split if set. Once all splits resolve, the acknowledgement of the received packet is written
+// according to the split ack policy.
+func (k *Keeper) WriteAcknowledgementForForwardedSplitPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.ForwardPacketData,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	splitForward, found := k.GetSplitForward(ctx, inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence)
+	if !found {
+		return fmt.Errorf("split forward not found for packet on channel (%s) port (%s) sequence (%d)",
+			inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence)
+	}
+
+	originalPacket := types.NewOriginalPacket(inFlightPacket)
+	nextHop := types.NewNextHopPacket(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	amount, ok := sdk.NewIntFromString(data.GetAmount())
+	if !ok {
+		return fmt.Errorf("failed to parse amount from packet data for split forward: %s", data.GetAmount())
+	}
+	token := sdk.NewCoin(transfertypes.ParseDenomTrace(data.GetDenom()).IBCDenom(), amount)
+
+	receiver, err := sdk.AccAddressFromBech32(splitForward.Receiver)
+	if err != nil {
+		return err
+	}
+
+	splitForward.Pending--
+
+	if ack.Success() {
+		if err := k.collectForwardFee(ctx, inFlightPacket, splitForward.Receiver); err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardAcked{
+			OriginalPacket: originalPacket,
+			NextHop:        nextHop,
+			Denom:          data.GetDenom(),
+			Amount:         data.GetAmount(),
+		}); err != nil {
+			return err
+		}
+
+		splitForward.Succeeded++
+	} else {
+		splitForward.Errors = append(splitForward.Errors, ack.GetError())
+
+		// the fee goes back to the receiver along with the funds of the split, which are then handled together.
+		if err := k.returnForwardFee(ctx, inFlightPacket, receiver); err != nil {
+			return err
+		}
+		funds := sdk.NewCoins(token).Add(inFlightPacket.Fee...)
+
+		k.releaseForwardFlow(
+			ctx,
+			inFlightPacket,
+			types.NewPortChannel(packet.SourcePort, packet.SourceChannel),
+			transfertypes.ParseDenomTrace(data.GetDenom()).BaseDenom, amount,
+		)
+
+		if inFlightPacket.RecoverAddress != "" {
+			if err := k.recoverForwardedFunds(ctx, splitForward.Receiver, inFlightPacket.RecoverAddress, funds); err != nil {
+				return err
+			}
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventForwardRecovered{
+				OriginalPacket: originalPacket,
+				NextHop:        nextHop,
+				Denom:          data.GetDenom(),
+				Amount:         data.GetAmount(),
+				RecoverAddress: inFlightPacket.RecoverAddress,
+				Error:          ack.GetError(),
+			}); err != nil {
+				return err
+			}
+
+			splitForward.Recovered++
+		} else {
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventSplitForwardFailed{
+				OriginalPacket: originalPacket,
+				NextHop:        nextHop,
+				Denom:          data.GetDenom(),
+				Amount:         data.GetAmount(),
+				Error:          ack.GetError(),
+			}); err != nil {
+				return err
+			}
+
+			// hold the funds while the received packet may still be refunded.
+			if splitForward.Refundable(inFlightPacket.Nonrefundable) {
+				if err := k.bankKeeper.SendCoins(ctx, receiver, types.SplitEscrowAddress(), funds); err != nil {
+					return fmt.Errorf("failed to hold funds of failed split: %w", err)
+				}
+				splitForward.Held = splitForward.Held.Add(funds...)
+			}
+		}
+	}
+
+	// once the received packet can no longer be refunded, funds held for a refund go to the receiver.
+	if !splitForward.Refundable(inFlightPacket.Nonrefundable) && !splitForward.Held.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, types.SplitEscrowAddress(), receiver, splitForward.Held); err != nil {
+			return fmt.Errorf("failed to release held split funds to receiver: %w", err)
+		}
+		splitForward.Held = nil
+	}
+
+	if splitForward.Pending > 0 {
+		k.SetSplitForward(ctx, inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence, splitForward)
+		return nil
+	}
+
+	k.splitForwardStore(ctx).Delete(types.RefundPacketKey(inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId, inFlightPacket.RefundSequence))
+
+	return k.completeSplitForward(ctx, inFlightPacket, splitForward)
+}
+
+// completeSplitForward writes the acknowledgement of a received packet once all of its splits resolved.
+func (k *Keeper) completeSplitForward(ctx sdk.Context, inFlightPacket *types.InFlightPacket, splitForward types.SplitForward) error {
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	failed := uint32(len(splitForward.Errors))
+	refunded := splitForward.Refundable(inFlightPacket.Nonrefundable)
+
+	var ack channeltypes.Acknowledgement
+	if refunded {
+		if err := k.refundHeldSplitFunds(ctx, inFlightPacket, splitForward.Held); err != nil {
+			return err
+		}
+		ack = channeltypes.NewErrorAcknowledgement(fmt.Errorf("all %d split forwards failed: %s", failed, strings.Join(splitForward.Errors, "; ")))
+	} else {
+		ack = channeltypes.NewResultAcknowledgement([]byte(fmt.Sprintf(
+			"split forward completed: %d succeeded, %d failed, %d recovered", splitForward.Succeeded, failed, splitForward.Recovered,
+		)))
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSplitForwardCompleted{
+		OriginalPacket: types.NewOriginalPacket(inFlightPacket),
+		Succeeded:      splitForward.Succeeded,
+		Failed:         failed,
+		Recovered:      splitForward.Recovered,
+		Refunded:       refunded,
+	}); err != nil {
+		return err
+	}
+
+	return k.writeOriginalPacketAcknowledgement(ctx, chanCap, inFlightPacket, ack)
+}
+
+// refundHeldSplitFunds moves the funds held for failed splits so that the error acknowledgement written back
+// refunds them on the chain the received packet came from: to the escrow of the received packet's channel if
+// the denom originated on this chain, burned otherwise.
+func (k *Keeper) refundHeldSplitFunds(ctx sdk.Context, inFlightPacket *types.InFlightPacket, held sdk.Coins) error {
+	for _, coin := range held {
+		fullDenomPath := coin.Denom
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			var err error
+			fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, coin.Denom)
+			if err != nil {
+				return err
+			}
+		}
+
+		if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
+			refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+			if err := k.bankKeeper.SendCoins(ctx, types.SplitEscrowAddress(), refundEscrowAddress, sdk.NewCoins(coin)); err != nil {
+				return fmt.Errorf("failed to send held split funds to refund escrow account: %w", err)
+			}
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, types.SplitEscrowAddress(), transfertypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+			return fmt.Errorf("failed to send held split funds to module account for burn: %w", err)
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+			panic(fmt.Sprintf("cannot burn coins after a successful send from split escrow account to module account: %v", err))
+		}
+	}
+	return nil
+}
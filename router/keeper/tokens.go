@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// forwardTokens are the tokens a forward moves on this chain: the coins of an ICS-20 transfer, or the NFTs of an
+// ICS-721 transfer, moved through the keepers of their transfer application. Forwards of either are sent, refunded
+// and retried through the same code path.
+type forwardTokens interface {
+	// denom returns the denom of the coins, or the class ID of the NFTs, on this chain.
+	denom() string
+	// amount returns the amount of the coins, or the token IDs of the NFTs separated by commas.
+	amount() string
+	// coin returns the coin of fungible tokens, which are the only ones charged forward fees and rate limited.
+	coin() (sdk.Coin, bool)
+	// fullPath returns the full denom path of the coins, or the full class path of the NFTs.
+	fullPath(ctx sdk.Context) (string, error)
+	// escrowAddress returns the address the tokens are escrowed in when sent over a channel.
+	escrowAddress(port, channel string) sdk.AccAddress
+	// send moves the tokens from one account on this chain to another.
+	send(ctx sdk.Context, from, to sdk.AccAddress) error
+	// burn burns the tokens held by an account.
+	burn(ctx sdk.Context, from sdk.AccAddress) error
+	// mint mints the tokens to an account, after they were burned when sent over a channel.
+	mint(ctx sdk.Context, to sdk.AccAddress) error
+	// transfer sends the tokens owned by sender on this chain to receiver at the other end of a channel, and returns
+	// the sequence of the sent packet.
+	transfer(
+		ctx sdk.Context,
+		port, channel string,
+		sender, receiver string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		memo string,
+	) (uint64, error)
+	// packetData returns the transfer packet data of the tokens with the given denom, or class ID.
+	packetData(denom, sender, receiver, memo string) types.ForwardPacketData
+}
+
+// packetTokens returns the tokens of transfer packet data. denom is the denom of the coins, or the class ID of the
+// NFTs, either on this chain or as its full path, usually the one of the packet data.
+func (k *Keeper) packetTokens(data types.ForwardPacketData, denom string) (forwardTokens, error) {
+	switch data := data.(type) {
+	case *transfertypes.FungibleTokenPacketData:
+		amount, ok := sdk.NewIntFromString(data.Amount)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse amount from packet data: %s", data.Amount)
+		}
+		return fungibleTokens{
+			k:     k,
+			path:  denom,
+			token: sdk.NewCoin(transfertypes.ParseDenomTrace(denom).IBCDenom(), amount),
+		}, nil
+	case *types.NonFungibleTokenPacketData:
+		if k.nftTransferKeeper == nil || k.nftKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "NFT forwarding is not enabled on this chain")
+		}
+		return nonFungibleTokens{k: k, path: denom, tokenIDs: data.TokenIDs}, nil
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "packet data of type %T cannot be forwarded", data)
+	}
+}
+
+// coinTokens returns the tokens of a coin on this chain.
+func (k *Keeper) coinTokens(coin sdk.Coin) forwardTokens {
+	return fungibleTokens{k: k, path: coin.Denom, token: coin}
+}
+
+// fungibleTokens are the coins of an ICS-20 transfer.
+type fungibleTokens struct {
+	k *Keeper
+	// path is the denom the coins were given with, either on this chain or as its full path.
+	path  string
+	token sdk.Coin
+}
+
+func (t fungibleTokens) denom() string {
+	return t.token.Denom
+}
+
+func (t fungibleTokens) amount() string {
+	return t.token.Amount.String()
+}
+
+func (t fungibleTokens) coin() (sdk.Coin, bool) {
+	return t.token, true
+}
+
+func (t fungibleTokens) fullPath(ctx sdk.Context) (string, error) {
+	if strings.HasPrefix(t.path, "ibc/") {
+		return t.k.transferKeeper.DenomPathFromHash(ctx, t.path)
+	}
+	return t.path, nil
+}
+
+func (t fungibleTokens) escrowAddress(port, channel string) sdk.AccAddress {
+	return transfertypes.GetEscrowAddress(port, channel)
+}
+
+func (t fungibleTokens) send(ctx sdk.Context, from, to sdk.AccAddress) error {
+	return t.k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(t.token))
+}
+
+func (t fungibleTokens) burn(ctx sdk.Context, from sdk.AccAddress) error {
+	coins := sdk.NewCoins(t.token)
+	if err := t.k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, transfertypes.ModuleName, coins); err != nil {
+		return fmt.Errorf("failed to send coins to module account for burn: %w", err)
+	}
+	if err := t.k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to module account: %v", err))
+	}
+	return nil
+}
+
+func (t fungibleTokens) mint(ctx sdk.Context, to sdk.AccAddress) error {
+	coins := sdk.NewCoins(t.token)
+	if err := t.k.bankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := t.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, to, coins); err != nil {
+		panic(fmt.Sprintf("cannot send coins after minting them to module account: %v", err))
+	}
+	return nil
+}
+
+func (t fungibleTokens) transfer(
+	ctx sdk.Context,
+	port, channel string,
+	sender, receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	res, err := t.k.transferKeeper.Transfer(
+		sdk.WrapSDKContext(ctx),
+		transfertypes.NewMsgTransfer(port, channel, t.token, sender, receiver, timeoutHeight, timeoutTimestamp, memo),
+	)
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	return res.Sequence, nil
+}
+
+func (t fungibleTokens) packetData(denom, sender, receiver, memo string) types.ForwardPacketData {
+	data := transfertypes.NewFungibleTokenPacketData(denom, t.token.Amount.String(), sender, receiver, memo)
+	return &data
+}
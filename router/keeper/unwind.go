@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// ExpandUnwinds expands the unwind of the forward, or of the forward of each split, of the metadata of a received
+// packet, see ForwardMetadata.ExpandUnwind. denom is the full denom path of the received token on this chain, or its
+// IBC denom, whose trace is then resolved. It must be called before the metadata is validated.
+func (k Keeper) ExpandUnwinds(ctx sdk.Context, m *types.PacketMetadata, denom string) error {
+	forwards := make([]*types.ForwardMetadata, 0, len(m.Splits)+1)
+	if m.Forward != nil {
+		forwards = append(forwards, m.Forward)
+	}
+	for _, split := range m.Splits {
+		if split != nil {
+			forwards = append(forwards, &split.ForwardMetadata)
+		}
+	}
+
+	denomPath := ""
+	for _, forward := range forwards {
+		if !forward.Unwind {
+			continue
+		}
+		if denomPath == "" {
+			denomPath = denom
+			if strings.HasPrefix(denom, "ibc/") {
+				var err error
+				if denomPath, err = k.transferKeeper.DenomPathFromHash(ctx, denom); err != nil {
+					return err
+				}
+			}
+		}
+		if err := forward.ExpandUnwind(denomPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
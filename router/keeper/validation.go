@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// ResolveForwards resolves the forward metadata of a received packet to the forwards it is sent as, the forward or
+// the forward of each split. Unwinds are expanded and chains of the registry resolved to the channels the forwards
+// are validated against, and the route is checked against the maximum number of forward hops. denomPath is the full
+// denom path of the received token on this chain, or its IBC denom, see ExpandUnwinds.
+func (k Keeper) ResolveForwards(ctx sdk.Context, m *types.PacketMetadata, denomPath string) ([]*types.ForwardMetadata, error) {
+	// unwinds are expanded first, as the bech32 prefix of the receiver of an unwind must not resolve it to a chain.
+	if err := k.ExpandUnwinds(ctx, m, denomPath); err != nil {
+		return nil, err
+	}
+	if err := k.ResolveForwardChains(ctx, m); err != nil {
+		return nil, err
+	}
+	forwards, err := m.Forwards()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CheckHopDepth(ctx, m); err != nil {
+		return nil, err
+	}
+	return forwards, nil
+}
+
+// CheckForward returns an error if a forward of a token received on the received channel exceeds the forward
+// limits, or is paused or not allowed by the routing policy for any channel it may be sent over, including its
+// failover channels. Errors of pauses wrap types.ErrForwardingPaused. baseDenom and denom are the base denom of the
+// token and its denom on this chain.
+func (k Keeper) CheckForward(ctx sdk.Context, received types.PortChannel, forward *types.ForwardMetadata, baseDenom, denom string) error {
+	// retries and timeouts set in the memo are checked against the limits before any funds move.
+	if limits := k.GetParams(ctx).ForwardLimits; limits != nil {
+		if err := limits.CheckForward(forward); err != nil {
+			return err
+		}
+	}
+	for _, next := range forward.NextChannels() {
+		if err := k.CheckPaused(ctx, received, next, baseDenom, denom); err != nil {
+			return err
+		}
+		if err := k.CheckRoutingPolicy(ctx, received, next, baseDenom, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
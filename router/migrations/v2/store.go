@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration moves all in-flight packets,
+// which were stored at the root of the module store in v1, under the in-flight packet key prefix.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	var keys, values [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	inFlightPacketStore := prefix.NewStore(store, types.InFlightPacketKeyPrefix)
+	for i, key := range keys {
+		store.Delete(key)
+		inFlightPacketStore.Set(key, values[i])
+	}
+
+	return nil
+}
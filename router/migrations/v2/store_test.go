@@ -0,0 +1,34 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/strangelove-ventures/packet-forward-middleware/v7/router/migrations/v2"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	keys := [][]byte{
+		types.RefundPacketKey("channel-0", "transfer", 1),
+		types.RefundPacketKey("channel-1", "transfer", 20),
+	}
+	for i, key := range keys {
+		store.Set(key, []byte{byte(i)})
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey))
+
+	inFlightPacketStore := prefix.NewStore(store, types.InFlightPacketKeyPrefix)
+	for i, key := range keys {
+		require.False(t, store.Has(key))
+		require.Equal(t, []byte{byte(i)}, inFlightPacketStore.Get(key))
+	}
+}
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/exported"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// Migrate migrates the router module state from the consensus version 2 to
+// version 3. Specifically, it takes the parameters that are currently stored
+// and managed by the x/params modules and stores them directly into the router
+// module state. Parameters that did not exist in the subspace start at their
+// defaults.
+func Migrate(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	legacySubspace exported.Subspace,
+	cdc codec.BinaryCodec,
+) error {
+	currParams := types.DefaultParams()
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&currParams)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
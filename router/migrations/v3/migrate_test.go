@@ -0,0 +1,40 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v3 "github.com/strangelove-ventures/packet-forward-middleware/v7/router/migrations/v3"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	store := ctx.KVStore(storeKey)
+
+	// the legacy subspace only holds the fee percentage, under its own prefix of the store.
+	legacySubspace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	legacySubspace.Set(ctx, types.KeyFeePercentage, sdk.NewDecWithPrec(5, 2))
+
+	require.NoError(t, v3.Migrate(ctx, store, legacySubspace, cdc))
+
+	var res types.Params
+	bz := store.Get(types.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+
+	// parameters added since the legacy subspace start at their defaults, e.g. the memo size and hop limits.
+	expected := types.DefaultParams()
+	expected.FeePercentage = sdk.NewDecWithPrec(5, 2)
+	require.Equal(t, expected, res)
+	require.Equal(t, types.DefaultMaxMemoSize, res.MaxMemoSize)
+	require.Equal(t, types.DefaultMaxForwardHops, res.MaxForwardHops)
+}
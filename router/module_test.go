@@ -3,16 +3,26 @@ package router_test
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/golang/mock/gomock"
 	"github.com/iancoleman/orderedmap"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/keeper"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/test"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/test/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,6 +30,7 @@ var (
 	testDenom     = "uatom"
 	testAmount    = "100"
 	testAmount256 = "100000000000000000000"
+	testClassID   = "nft-class"
 
 	testSourcePort         = "transfer"
 	testSourceChannel      = "channel-10"
@@ -32,6 +43,17 @@ func makeIBCDenom(port, channel, denom string) string {
 	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
 }
 
+// requireEventEmitted asserts that a typed event of the same type as msg was emitted on ctx.
+func requireEventEmitted(t *testing.T, ctx sdk.Context, msg proto.Message) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(msg) {
+			return
+		}
+	}
+	require.Failf(t, "event not emitted", "expected %s", proto.MessageName(msg))
+}
+
 func emptyPacket() channeltypes.Packet {
 	return channeltypes.Packet{}
 }
@@ -364,7 +386,8 @@ func TestOnRecvPacket_ForwardWithFee(t *testing.T) {
 	forwardMiddleware := setup.ForwardMiddleware
 
 	// Set fee param to 10%
-	setup.Keepers.RouterKeeper.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(10, 2)))
+	err = setup.Keepers.RouterKeeper.SetParams(ctx, types.NewParams(sdk.NewDecWithPrec(10, 2)))
+	require.NoError(t, err)
 
 	// Test data
 	const (
@@ -386,6 +409,8 @@ func TestOnRecvPacket_ForwardWithFee(t *testing.T) {
 		},
 	})
 	packetFwd := transferPacket(t, destAddr, nil)
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
 	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
 	successAck := cdc.MustMarshalJSON(&acknowledgement)
 
@@ -394,11 +419,9 @@ func TestOnRecvPacket_ForwardWithFee(t *testing.T) {
 		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
 			Return(acknowledgement),
 
-		setup.Mocks.DistributionKeeperMock.EXPECT().FundCommunityPool(
-			ctx,
-			feeCoins,
-			hostAccAddr,
-		).Return(nil),
+		// the fee is held in escrow while the forward is in flight.
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.FeeEscrowAddress(), feeCoins).
+			Return(nil),
 
 		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
 			sdk.WrapSDKContext(ctx),
@@ -414,7 +437,17 @@ func TestOnRecvPacket_ForwardWithFee(t *testing.T) {
 			),
 		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr).
+		// and paid to the fee recipient once the forward is acknowledged.
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
+
+		setup.Mocks.DistributionKeeperMock.EXPECT().FundCommunityPool(
+			gomock.Any(),
+			feeCoins,
+			types.FeeEscrowAddress(),
+		).Return(nil),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), acknowledgement).
 			Return(nil),
 	)
 
@@ -422,106 +455,92 @@ func TestOnRecvPacket_ForwardWithFee(t *testing.T) {
 	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
 	require.Nil(t, ack)
 
+	requireEventEmitted(t, ctx, &types.EventForwardInitiated{})
+
+	inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.True(t, found)
+	require.Equal(t, feeCoins, inFlightPacket.Fee)
+
 	// ack returned from chain C
 	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr)
 	require.NoError(t, err)
+
+	requireEventEmitted(t, ctx, &types.EventFeeCollected{})
 }
 
-func TestOnRecvPacket_ForwardMultihopStringNext(t *testing.T) {
-	var err error
+func TestOnRecvPacket_ForwardWithFeeBurned(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
 	setup := test.NewTestSetup(t, ctl)
 	ctx := setup.Initializer.Ctx
-	cdc := setup.Initializer.Marshaler
 	forwardMiddleware := setup.ForwardMiddleware
 
+	// Set fee param to 10%, burning fees
+	params := types.NewParams(sdk.NewDecWithPrec(10, 2))
+	params.FeeRecipient = types.FeeRecipient{Type: types.FeeRecipientTypeBurn}
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
 	// Test data
 	const (
-		hostAddr  = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
-		hostAddr2 = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
-		destAddr  = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
-		port      = "transfer"
-		channel   = "channel-0"
-		channel2  = "channel-1"
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
 	)
-
 	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
 	senderAccAddr := test.AccAddress()
-	senderAccAddr2 := test.AccAddress()
-	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
-	nextMetadata := &types.PacketMetadata{
-		Forward: &types.ForwardMetadata{
-			Receiver: destAddr,
-			Port:     port,
-			Channel:  channel2,
-		},
-	}
-	nextBz, err := json.Marshal(nextMetadata)
-	require.NoError(t, err)
-
+	hostAccAddr := test.AccAddressFromBech32(t, hostAddr)
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(90))
+	feeCoins := sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(10))}
 	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
 		Forward: &types.ForwardMetadata{
-			Receiver: hostAddr2,
+			Receiver: destAddr,
 			Port:     port,
 			Channel:  channel,
-			Next:     types.NewJSONObject(false, nextBz, orderedmap.OrderedMap{}),
 		},
 	})
-	packet2 := transferPacket(t, hostAddr2, nextMetadata)
 	packetFwd := transferPacket(t, destAddr, nil)
-
-	memo1, err := json.Marshal(nextMetadata)
-	require.NoError(t, err)
-
-	msgTransfer1 := transfertypes.NewMsgTransfer(
-		port,
-		channel,
-		testCoin,
-		hostAddr,
-		hostAddr2,
-		keeper.DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
-		string(memo1),
-	)
-
-	// no memo on final forward
-	msgTransfer2 := transfertypes.NewMsgTransfer(
-		port,
-		channel2,
-		testCoin,
-		hostAddr2,
-		destAddr,
-		keeper.DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
-		"",
-	)
-
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
 	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
-	successAck := cdc.MustMarshalJSON(&acknowledgement)
+	successAck := channeltypes.SubModuleCdc.MustMarshalJSON(&acknowledgement)
 
 	// Expected mocks
 	gomock.InOrder(
 		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
 			Return(acknowledgement),
 
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.FeeEscrowAddress(), feeCoins).
+			Return(nil),
+
 		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
 			sdk.WrapSDKContext(ctx),
-			msgTransfer1,
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				hostAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
 		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet2, senderAccAddr2).
-			Return(acknowledgement),
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
 
-		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
-			sdk.WrapSDKContext(ctx),
-			msgTransfer2,
-		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(
+			gomock.Any(),
+			types.FeeEscrowAddress(),
+			transfertypes.ModuleName,
+			feeCoins,
+		).Return(nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2).
+		setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(gomock.Any(), transfertypes.ModuleName, feeCoins).
 			Return(nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr).
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), acknowledgement).
 			Return(nil),
 	)
 
@@ -529,118 +548,2180 @@ func TestOnRecvPacket_ForwardMultihopStringNext(t *testing.T) {
 	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
 	require.Nil(t, ack)
 
-	// chain C with router module receives packet and forwards. ack should be nil so that it is not written yet.
-	ack = forwardMiddleware.OnRecvPacket(ctx, packet2, senderAccAddr2)
-	require.Nil(t, ack)
+	require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr))
 
-	// ack returned from chain D to chain C
-	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2)
-	require.NoError(t, err)
+	requireEventEmitted(t, ctx, &types.EventFeeCollected{})
+}
 
-	// ack returned from chain C to chain B
-	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
-	require.NoError(t, err)
+func TestOnRecvPacket_ForwardWithFeePaymentFailed(t *testing.T) {
+	// Test data
+	const (
+		hostAddr     = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr     = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		feeRecipient = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
+		port         = "transfer"
+		channel      = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	hostAccAddr := test.AccAddressFromBech32(t, hostAddr)
+	feeCoins := sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(10))}
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	})
+	packetFwd := transferPacket(t, destAddr, nil)
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	successAck := channeltypes.SubModuleCdc.MustMarshalJSON(&acknowledgement)
+
+	tests := []struct {
+		name      string
+		recipient types.FeeRecipient
+		// expectPayment sets the expected calls of a failed payment of the fee.
+		expectPayment func(bankKeeper *mock.MockBankKeeper) *gomock.Call
+	}{
+		{
+			name:      "payment fails",
+			recipient: types.FeeRecipient{Type: types.FeeRecipientTypeAddress, Address: feeRecipient},
+			expectPayment: func(bankKeeper *mock.MockBankKeeper) *gomock.Call {
+				return bankKeeper.EXPECT().
+					SendCoins(gomock.Any(), types.FeeEscrowAddress(), test.AccAddressFromBech32(t, feeRecipient), feeCoins).
+					Return(sdkerrors.ErrUnauthorized)
+			},
+		},
+		{
+			name:      "payment panics",
+			recipient: types.FeeRecipient{Type: types.FeeRecipientTypeBurn},
+			expectPayment: func(bankKeeper *mock.MockBankKeeper) *gomock.Call {
+				bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), types.FeeEscrowAddress(), transfertypes.ModuleName, feeCoins).
+					Return(nil)
+				return bankKeeper.EXPECT().BurnCoins(gomock.Any(), transfertypes.ModuleName, feeCoins).
+					DoAndReturn(func(sdk.Context, string, sdk.Coins) error {
+						panic("module account transfer does not have permissions to burn tokens")
+					})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctl := gomock.NewController(t)
+			defer ctl.Finish()
+			setup := test.NewTestSetup(t, ctl)
+			ctx := setup.Initializer.Ctx
+			forwardMiddleware := setup.ForwardMiddleware
+
+			params := types.NewParams(sdk.NewDecWithPrec(10, 2))
+			params.FeeRecipient = tc.recipient
+			require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+			gomock.InOrder(
+				setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+					Return(acknowledgement),
+				setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.FeeEscrowAddress(), feeCoins).
+					Return(nil),
+				setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+					Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+				setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+					Return(transfertypes.ModuleName, nil, nil),
+				tc.expectPayment(setup.Mocks.BankKeeperMock),
+
+				// the fee is left in the fee escrow account and the forward is acknowledged regardless.
+				setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), acknowledgement).
+					Return(nil),
+			)
+
+			require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+			require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr))
+
+			for _, event := range ctx.EventManager().Events() {
+				require.NotEqual(t, proto.MessageName(&types.EventFeeCollected{}), event.Type)
+			}
+			requireEventEmitted(t, ctx, &types.EventForwardAcked{})
+		})
+	}
 }
 
-func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
-	var err error
+func TestOnRecvPacket_ForwardWithFeeRefunded(t *testing.T) {
 	ctl := gomock.NewController(t)
 	defer ctl.Finish()
 	setup := test.NewTestSetup(t, ctl)
 	ctx := setup.Initializer.Ctx
-	cdc := setup.Initializer.Marshaler
 	forwardMiddleware := setup.ForwardMiddleware
 
 	// Test data
 	const (
-		hostAddr  = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
-		hostAddr2 = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
-		destAddr  = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
-		port      = "transfer"
-		channel   = "channel-0"
-		channel2  = "channel-1"
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
 	)
-
+	denomPath := testDestinationPort + "/" + testDestinationChannel + "/" + testDenom
 	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
 	senderAccAddr := test.AccAddress()
-	senderAccAddr2 := test.AccAddress()
-	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
-	nextMetadata := &types.PacketMetadata{
+	hostAccAddr := test.AccAddressFromBech32(t, hostAddr)
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(90))
+	feeCoins := sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(10))}
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
 		Forward: &types.ForwardMetadata{
 			Receiver: destAddr,
 			Port:     port,
-			Channel:  channel2,
+			Channel:  channel,
 		},
+	})
+	packetFwd := packetOrig
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
+	packetFwd.Data = transfertypes.NewFungibleTokenPacketData(denomPath, "90", hostAddr, destAddr, "").GetBytes()
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("receive disabled"))
+	errorAckBz := channeltypes.SubModuleCdc.MustMarshalJSON(&errorAck)
+	escrowAddr := transfertypes.GetEscrowAddress(port, channel)
+
+	// Set fee param to 10%, and rate limits on the channel the packet is received on and the next hop channel.
+	params := types.NewParams(sdk.NewDecWithPrec(10, 2))
+	params.RateLimits = []types.RateLimit{
+		{Port: testDestinationPort, Channel: testDestinationChannel, Denom: testDenom, MaxInflow: sdk.NewInt(1000), WindowBlocks: 100},
+		{Port: port, Channel: channel, Denom: testDenom, MaxOutflow: sdk.NewInt(1000), WindowBlocks: 100},
 	}
-	nextBz, err := json.Marshal(nextMetadata)
-	require.NoError(t, err)
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
 
-	nextJSONObject := new(types.JSONObject)
-	err = json.Unmarshal(nextBz, nextJSONObject)
-	require.NoError(t, err)
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).
+			Return(denomPath, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.FeeEscrowAddress(), feeCoins).
+			Return(nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		// the voucher minted when the packet was received is burned in full, including the fee, when the
+		// forward is refunded.
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(ctx, escrowAddr, transfertypes.ModuleName, sdk.NewCoins(testCoin)).
+			Return(nil),
+		setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(testCoin)).
+			Return(nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(ctx, types.FeeEscrowAddress(), transfertypes.ModuleName, feeCoins).
+			Return(nil),
+		setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(ctx, transfertypes.ModuleName, feeCoins).
+			Return(nil),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), errorAck).
+			Return(nil),
+	)
 
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	// the inflow includes the fee, the outflow does not.
+	require.Equal(t, sdk.NewInt(100), setup.Keepers.RouterKeeper.GetRateLimitFlow(ctx, params.RateLimits[0]).Inflow())
+	require.Equal(t, sdk.NewInt(90), setup.Keepers.RouterKeeper.GetRateLimitFlow(ctx, params.RateLimits[1]).Outflow())
+
+	require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, errorAckBz, senderAccAddr))
+
+	// the refund releases all the quota the forward used.
+	require.True(t, setup.Keepers.RouterKeeper.GetRateLimitFlow(ctx, params.RateLimits[0]).Inflow().IsZero())
+	require.True(t, setup.Keepers.RouterKeeper.GetRateLimitFlow(ctx, params.RateLimits[1]).Outflow().IsZero())
+
+	requireEventEmitted(t, ctx, &types.EventForwardRefunded{})
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, proto.MessageName(&types.EventFeeCollected{}), event.Type)
+	}
+}
+
+func TestOnRecvPacket_ForwardRejectedByRoutingPolicy(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Deny forwards of the test denom
+	params := types.DefaultParams()
+	params.RoutingPolicy = types.RoutingPolicy{DeniedDenoms: []string{testDenom}}
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+	senderAccAddr := test.AccAddress()
 	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
 		Forward: &types.ForwardMetadata{
-			Receiver: hostAddr2,
-			Port:     port,
-			Channel:  channel,
-			Next:     nextJSONObject,
+			Receiver: destAddr,
+			Port:     "transfer",
+			Channel:  "channel-0",
 		},
 	})
-	packet2 := transferPacket(t, hostAddr2, nextMetadata)
-	packetFwd := transferPacket(t, destAddr, nil)
 
-	memo1, err := json.Marshal(nextMetadata)
+	// No mocks are expected, the packet must be rejected before the underlying app is called.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	err := cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck)
 	require.NoError(t, err)
+	require.Equal(t, "ABCI code: 4: error handling packet: see events for details", expectedAck.GetError())
 
-	msgTransfer1 := transfertypes.NewMsgTransfer(
-		port,
-		channel,
-		testCoin,
-		hostAddr,
-		hostAddr2,
-		keeper.DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
-		string(memo1),
-	)
+	requireEventEmitted(t, ctx, &types.EventForwardRejected{})
+}
 
-	// no memo on final forward
-	msgTransfer2 := transfertypes.NewMsgTransfer(
-		port,
-		channel2,
-		testCoin,
-		hostAddr2,
-		destAddr,
-		keeper.DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
-		"",
+func TestOnRecvPacket_ForwardRateLimited(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
 	)
 
+	// Allow 150 of the test denom to leave on the next hop channel per 100 blocks
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{{
+		Port:         port,
+		Channel:      channel,
+		Denom:        testDenom,
+		MaxOutflow:   sdk.NewInt(150),
+		WindowBlocks: 100,
+	}}
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetDenomPrefix(testDestinationPort, testDestinationChannel) + testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	})
 	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
-	successAck := cdc.MustMarshalJSON(&acknowledgement)
 
 	// Expected mocks
 	gomock.InOrder(
 		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
 			Return(acknowledgement),
 
+		setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denomTrace.IBCDenom()).
+			Return(denomTrace.GetFullDenomPath(), nil),
+
 		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
 			sdk.WrapSDKContext(ctx),
-			msgTransfer1,
-		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				hostAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denomTrace.IBCDenom()).
+			Return(denomTrace.GetFullDenomPath(), nil),
+	)
+
+	// the first forward is within the quota.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// the second forward would exceed the quota.
+	ack = forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	err := cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck)
+	require.NoError(t, err)
+	require.Equal(t, "ABCI code: 5: error handling packet: see events for details", expectedAck.GetError())
+	requireEventEmitted(t, ctx, &types.EventForwardRejected{})
+
+	flow := setup.Keepers.RouterKeeper.GetRateLimitFlow(ctx, params.RateLimits[0])
+	require.Equal(t, sdk.NewInt(100), flow.Outflow())
+}
+
+func TestOnRecvPacket_ForwardRateLimitRollingWindow(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+	routerKeeper := setup.Keepers.RouterKeeper
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{{
+		Port:         port,
+		Channel:      channel,
+		Denom:        testDenom,
+		MaxOutflow:   sdk.NewInt(150),
+		WindowBlocks: 100,
+	}}
+	require.NoError(t, routerKeeper.SetParams(ctx, params))
+
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetDenomPrefix(testDestinationPort, testDestinationChannel) + testDenom)
+	senderAccAddr := test.AccAddress()
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	})
+	packetFwd := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{
+			Sequence:      sequence,
+			SourcePort:    port,
+			SourceChannel: channel,
+			Data:          transfertypes.NewFungibleTokenPacketData(denomTrace.GetFullDenomPath(), "100", hostAddr, destAddr, "").GetBytes(),
+		}
+	}
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("receive disabled"))
+	errorAckBz := channeltypes.SubModuleCdc.MustMarshalJSON(&errorAck)
+
+	setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(gomock.Any(), packetOrig, senderAccAddr).
+		Return(channeltypes.NewResultAcknowledgement([]byte("test"))).AnyTimes()
+	setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(gomock.Any(), denomTrace.IBCDenom()).
+		Return(denomTrace.GetFullDenomPath(), nil).AnyTimes()
+	gomock.InOrder(
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+	)
+	setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(gomock.Any(), testDestinationPort, testDestinationChannel).
+		Return(transfertypes.ModuleName, nil, nil).Times(2)
+	setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), transfertypes.ModuleName, gomock.Any()).
+		Return(nil).Times(2)
+	setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(gomock.Any(), transfertypes.ModuleName, gomock.Any()).
+		Return(nil).Times(2)
+	setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(gomock.Any(), nil, gomock.Any(), errorAck).
+		Return(nil).Times(2)
+
+	outflow := func(ctx sdk.Context) sdk.Int {
+		return routerKeeper.GetRateLimitFlow(ctx, params.RateLimits[0]).Outflow()
+	}
+
+	// the first forward is recorded in the first bucket of the window.
+	ctx = ctx.WithBlockHeight(10)
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	// until the window rolls past it, it counts towards the quota.
+	ctx = ctx.WithBlockHeight(105)
+	require.False(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr).Success())
+	require.Equal(t, sdk.NewInt(100), outflow(ctx))
+
+	ctx = ctx.WithBlockHeight(110)
+	require.True(t, outflow(ctx).IsZero())
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+	require.Equal(t, sdk.NewInt(100), outflow(ctx))
+
+	// refunding the first forward does not release the quota used by the second one in a later window.
+	require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd(0), errorAckBz, senderAccAddr))
+	require.Equal(t, sdk.NewInt(100), outflow(ctx))
+
+	// refunding the second forward releases the quota it used.
+	require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd(1), errorAckBz, senderAccAddr))
+	require.True(t, outflow(ctx).IsZero())
+}
+
+func TestOnRecvPacket_ForwardPaused(t *testing.T) {
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+	metadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     "transfer",
+			Channel:  "channel-0",
+		},
+	}
+
+	t.Run("error ack", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		forwardMiddleware := setup.ForwardMiddleware
+
+		setup.Keepers.RouterKeeper.SetPaused(ctx, types.NewPauseScope("", "", ""), true)
+
+		// No mocks are expected, the packet must be rejected before the underlying app is called.
+		ack := forwardMiddleware.OnRecvPacket(ctx, transferPacket(t, hostAddr, metadata), test.AccAddress())
+		require.False(t, ack.Success())
+		requireEventEmitted(t, ctx, &types.EventForwardRejected{})
+	})
+
+	t.Run("pass through", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		forwardMiddleware := setup.ForwardMiddleware
+
+		params := types.DefaultParams()
+		params.PausedForwardBehavior = types.PausedForwardBehaviorPassThrough
+		require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+		setup.Keepers.RouterKeeper.SetPaused(ctx, types.NewPauseScope("", "", testDenom), true)
+
+		senderAccAddr := test.AccAddress()
+		packet := transferPacket(t, hostAddr, metadata)
+
+		// the underlying app receives the packet, but it is not forwarded.
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+
+		ack := forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+		require.True(t, ack.Success())
+	})
+}
+
+func TestOnRecvPacket_ForwardTimeoutHeight(t *testing.T) {
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	recvAck := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	msgTransfer := func(ctx sdk.Context, timeoutHeight clienttypes.Height) *transfertypes.MsgTransfer {
+		return transfertypes.NewMsgTransfer(
+			port, channel, testCoin, hostAddr, destAddr, timeoutHeight,
+			uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+			"",
+		)
+	}
+
+	t.Run("requested timeout height", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+			Forward: &types.ForwardMetadata{
+				Receiver:      destAddr,
+				Port:          port,
+				Channel:       channel,
+				TimeoutHeight: "1-500",
+			},
+		})
+
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer(ctx, clienttypes.NewHeight(1, 500))).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+		)
+
+		ack := setup.ForwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.Nil(t, ack)
+
+		inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+		require.True(t, found)
+		require.Equal(t, "1-500", inFlightPacket.TimeoutHeight)
+	})
+
+	t.Run("timeout height derived from the next hop's client", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		forwardMiddleware := router.NewIBCMiddleware(
+			setup.Mocks.IBCModuleMock, setup.Keepers.RouterKeeper, 1,
+			keeper.DefaultForwardTransferPacketTimeoutTimestamp, keeper.DefaultRefundTransferPacketTimeoutTimestamp, 100,
+		)
+		packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+			Forward: &types.ForwardMetadata{
+				Receiver: destAddr,
+				Port:     port,
+				Channel:  channel,
+			},
+		})
+		fwdData := transfertypes.NewFungibleTokenPacketData(
+			testDestinationPort+"/"+testDestinationChannel+"/"+testDenom, testAmount, hostAddr, destAddr, "",
+		)
+		packetFwd := channeltypes.Packet{SourcePort: port, SourceChannel: channel, Data: fwdData.GetBytes()}
+
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck),
+			setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, channel).
+				Return("07-tendermint-0", &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1000)}, nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer(ctx, clienttypes.NewHeight(1, 1100))).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+			// the retry derives the timeout height again from the client's new latest height.
+			setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwd, senderAccAddr).Return(nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, channel).
+				Return("07-tendermint-0", &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1100)}, nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer(ctx, clienttypes.NewHeight(1, 1200))).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+		)
+
+		ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.Nil(t, ack)
+
+		err := forwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr)
+		require.NoError(t, err)
+
+		inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 1)
+		require.True(t, found)
+		require.Equal(t, uint64(100), inFlightPacket.TimeoutHeightOffset)
+	})
+}
+
+func TestOnRecvPacket_ForwardRecovered(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr    = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr    = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		recoverAddr = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
+		port        = "transfer"
+		channel     = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver:       destAddr,
+			Port:           port,
+			Channel:        channel,
+			RecoverAddress: recoverAddr,
+		},
+	})
+	fwdData := transfertypes.NewFungibleTokenPacketData(
+		testDestinationPort+"/"+testDestinationChannel+"/"+testDenom, testAmount, hostAddr, destAddr, "",
+	)
+	packetFwd := channeltypes.Packet{SourcePort: port, SourceChannel: channel, Data: fwdData.GetBytes()}
+
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed to receive"))
+	failedAck := cdc.MustMarshalJSON(&errorAck)
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		// the transfer app refunds the failed forward to the receiver on this chain.
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, failedAck, senderAccAddr).
+			Return(nil),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx, test.AccAddressFromBech32(t, hostAddr), test.AccAddressFromBech32(t, recoverAddr), sdk.NewCoins(testCoin),
+		).Return(nil),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, _ any, _ any, ack ibcexported.Acknowledgement) error {
+				require.True(t, ack.Success())
+				return nil
+			}),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	err := forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, failedAck, senderAccAddr)
+	require.NoError(t, err)
+	requireEventEmitted(t, ctx, &types.EventForwardRecovered{})
+
+	_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.False(t, found)
+}
+
+func TestOnRecvPacket_ForwardSplits(t *testing.T) {
+	// Test data
+	const (
+		hostAddr  = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddrA = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		destAddrB = "cosmos1l505zhahp24v5jsmps9vs5asah759fdce06sfp"
+		port      = "transfer"
+		channelA  = "channel-0"
+		channelB  = "channel-1"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	fullDenomPath := testDestinationPort + "/" + testDestinationChannel + "/" + testDenom
+	senderAccAddr := test.AccAddress()
+	hostAccAddr := test.AccAddressFromBech32(t, hostAddr)
+	coinA := sdk.NewCoin(denom, sdk.NewInt(60))
+	coinB := sdk.NewCoin(denom, sdk.NewInt(40))
+
+	splitPacket := func(t *testing.T, policy string) channeltypes.Packet {
+		return transferPacket(t, hostAddr, &types.PacketMetadata{
+			Splits: []*types.SplitForwardMetadata{
+				{ForwardMetadata: types.ForwardMetadata{Receiver: destAddrA, Port: port, Channel: channelA}, Amount: "60"},
+				{ForwardMetadata: types.ForwardMetadata{Receiver: destAddrB, Port: port, Channel: channelB}, Percentage: "100"},
+			},
+			SplitAckPolicy: policy,
+		})
+	}
+	forwardedPacket := func(channel, receiver string, coin sdk.Coin) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(fullDenomPath, coin.Amount.String(), hostAddr, receiver, "")
+		return channeltypes.Packet{SourcePort: port, SourceChannel: channel, Data: data.GetBytes()}
+	}
+	packetFwdA := forwardedPacket(channelA, destAddrA, coinA)
+	packetFwdB := forwardedPacket(channelB, destAddrB, coinB)
+
+	recvAck := channeltypes.NewResultAcknowledgement([]byte("test"))
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed to receive"))
+
+	expectForwards := func(setup *test.Setup, ctx sdk.Context, packetOrig channeltypes.Packet) []*gomock.Call {
+		timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+		return []*gomock.Call{
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck),
+			// the splits are sent with their own event manager.
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+				gomock.Any(),
+				transfertypes.NewMsgTransfer(port, channelA, coinA, hostAddr, destAddrA, keeper.DefaultTransferPacketTimeoutHeight, timeout, ""),
+			).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+				gomock.Any(),
+				transfertypes.NewMsgTransfer(port, channelB, coinB, hostAddr, destAddrB, keeper.DefaultTransferPacketTimeoutHeight, timeout, ""),
+			).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+		}
+	}
+
+	t.Run("all or nothing refunds when all splits fail", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		cdc := setup.Initializer.Marshaler
+		forwardMiddleware := setup.ForwardMiddleware
+		failedAck := cdc.MustMarshalJSON(&errorAck)
+		packetOrig := splitPacket(t, "")
+
+		calls := expectForwards(setup, ctx, packetOrig)
+		calls = append(calls,
+			setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwdA, failedAck, senderAccAddr).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.SplitEscrowAddress(), sdk.NewCoins(coinA)).Return(nil),
+			setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwdB, failedAck, senderAccAddr).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.SplitEscrowAddress(), sdk.NewCoins(coinB)).Return(nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+				Return(transfertypes.ModuleName, nil, nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).Return(fullDenomPath, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(ctx, types.SplitEscrowAddress(), transfertypes.ModuleName, sdk.NewCoins(coinA.Add(coinB))).
+				Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(coinA.Add(coinB))).Return(nil),
+			setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ any, _ any, ack ibcexported.Acknowledgement) error {
+					require.False(t, ack.Success())
+					return nil
+				}),
+		)
+		gomock.InOrder(calls...)
+
+		ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.Nil(t, ack)
+		requireEventEmitted(t, ctx, &types.EventForwardInitiated{})
+
+		require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwdA, failedAck, senderAccAddr))
+		_, found := setup.Keepers.RouterKeeper.GetSplitForward(ctx, testDestinationChannel, testDestinationPort, 0)
+		require.True(t, found)
+
+		require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwdB, failedAck, senderAccAddr))
+		_, found = setup.Keepers.RouterKeeper.GetSplitForward(ctx, testDestinationChannel, testDestinationPort, 0)
+		require.False(t, found)
+		requireEventEmitted(t, ctx, &types.EventSplitForwardFailed{})
+		requireEventEmitted(t, ctx, &types.EventSplitForwardCompleted{})
+	})
+
+	t.Run("all or nothing releases held funds once a split succeeds", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		cdc := setup.Initializer.Marshaler
+		forwardMiddleware := setup.ForwardMiddleware
+		failedAck := cdc.MustMarshalJSON(&errorAck)
+		successAck := cdc.MustMarshalJSON(&recvAck)
+		packetOrig := splitPacket(t, "all_or_nothing")
+
+		calls := expectForwards(setup, ctx, packetOrig)
+		calls = append(calls,
+			setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwdA, failedAck, senderAccAddr).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.SplitEscrowAddress(), sdk.NewCoins(coinA)).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, types.SplitEscrowAddress(), hostAccAddr, sdk.NewCoins(coinA)).Return(nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+				Return(transfertypes.ModuleName, nil, nil),
+			setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ any, _ any, ack ibcexported.Acknowledgement) error {
+					require.True(t, ack.Success())
+					return nil
+				}),
+		)
+		gomock.InOrder(calls...)
+
+		ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.Nil(t, ack)
+
+		require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwdA, failedAck, senderAccAddr))
+		require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwdB, successAck, senderAccAddr))
+		requireEventEmitted(t, ctx, &types.EventSplitForwardCompleted{})
+	})
+
+	t.Run("partial leaves failed funds with the receiver", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		cdc := setup.Initializer.Marshaler
+		forwardMiddleware := setup.ForwardMiddleware
+		failedAck := cdc.MustMarshalJSON(&errorAck)
+		packetOrig := splitPacket(t, "partial")
+
+		calls := expectForwards(setup, ctx, packetOrig)
+		calls = append(calls,
+			setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwdA, failedAck, senderAccAddr).Return(nil),
+			setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwdB, senderAccAddr).Return(nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+				Return(transfertypes.ModuleName, nil, nil),
+			setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ any, _ any, ack ibcexported.Acknowledgement) error {
+					require.True(t, ack.Success())
+					return nil
+				}),
+		)
+		gomock.InOrder(calls...)
+
+		ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.Nil(t, ack)
+
+		require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwdA, failedAck, senderAccAddr))
+		// no retries remain, so the timeout fails the split.
+		require.NoError(t, forwardMiddleware.OnTimeoutPacket(ctx, packetFwdB, senderAccAddr))
+		requireEventEmitted(t, ctx, &types.EventForwardGaveUp{})
+	})
+
+	t.Run("no split events when a split fails to send", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		packetOrig := splitPacket(t, "")
+
+		calls := expectForwards(setup, ctx, packetOrig)
+		calls[2].Return(nil, fmt.Errorf("channel closed"))
+		gomock.InOrder(calls...)
+
+		ack := setup.ForwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.False(t, ack.Success())
+		requireEventEmitted(t, ctx, &types.EventForwardRejected{})
+		for _, event := range ctx.EventManager().Events() {
+			require.NotEqual(t, proto.MessageName(&types.EventForwardInitiated{}), event.Type)
+		}
+	})
+
+	t.Run("invalid split amounts", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+			Splits: []*types.SplitForwardMetadata{
+				{ForwardMetadata: types.ForwardMetadata{Receiver: destAddrA, Port: port, Channel: channelA}, Amount: "60"},
+				{ForwardMetadata: types.ForwardMetadata{Receiver: destAddrB, Port: port, Channel: channelB}, Amount: "60"},
+			},
+		})
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck)
+
+		ack := setup.ForwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+		require.False(t, ack.Success())
+		requireEventEmitted(t, ctx, &types.EventForwardRejected{})
+	})
+}
+
+func TestOnRecvPacket_ForwardChain(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-141"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+
+	require.NoError(t, setup.Keepers.RouterKeeper.SetChainRoute(ctx, types.ChainRoute{
+		ChainId: "cosmoshub-4", Port: port, Channel: channel,
+		Aliases: []string{"hub"}, Bech32Prefixes: []string{"cosmos"},
+	}))
+	require.NoError(t, setup.Keepers.RouterKeeper.SetChainRoute(ctx, types.ChainRoute{
+		ChainId: "osmosis-1", Port: port, Channel: "channel-0", Bech32Prefixes: []string{"osmo"},
+	}))
+
+	// the chain alias is resolved to the channel of the chain.
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Chain:    "hub",
+		},
+	})
+	// a receiver with the bech32 prefix of another chain is rejected before any funds move.
+	packetMismatch := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Chain:    "osmosis-1",
+		},
+	})
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				hostAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetMismatch, senderAccAddr)
+	require.False(t, ack.Success())
+}
+
+func TestOnRecvPacket_ForwardIntermediateReceiver(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	metadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	}
+
+	params := types.DefaultParams()
+	params.DeriveIntermediateReceiver = true
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+	// whatever receiver the sender set is replaced with the derived one.
+	intermediateAddr := types.IntermediateReceiver(testDestinationChannel, "").String()
+	packetOrig := transferPacket(t, "not-an-address", metadata)
+	packetRecv := packetOrig
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packetOrig.Data, &data))
+	data.Receiver = intermediateAddr
+	packetRecv.Data = data.GetBytes()
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetRecv, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+	require.NotEqual(t, intermediateAddr, types.IntermediateReceiver("channel-12", "").String())
+}
+
+func TestOnRecvPacket_ForwardLimits(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+
+	params := types.DefaultParams()
+	params.ForwardDefaults = &types.ForwardDefaults{Retries: 1, Timeout: 30 * time.Minute}
+	params.ForwardLimits = &types.ForwardLimits{MaxRetries: 2, MinTimeout: time.Minute, MaxTimeout: time.Hour}
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+	expectForward := func(packet channeltypes.Packet, timeout time.Duration) {
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet, senderAccAddr).
+				Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+				sdk.WrapSDKContext(ctx),
+				transfertypes.NewMsgTransfer(
+					port,
+					channel,
+					testCoin,
+					hostAddr,
+					destAddr,
+					keeper.DefaultTransferPacketTimeoutHeight,
+					uint64(ctx.BlockTime().UnixNano())+uint64(timeout.Nanoseconds()),
+					"",
+				),
+			).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+		)
+	}
+
+	// forwards that do not set retries or a timeout use the defaults set by governance.
+	packet := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{Receiver: destAddr, Port: port, Channel: channel},
+	})
+	expectForward(packet, 30*time.Minute)
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr))
+	inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.True(t, found)
+	require.Equal(t, int32(1), inFlightPacket.RetriesRemaining)
+
+	// retries and timeouts set in the memo beyond the limits are clamped to them.
+	retries := uint8(255)
+	packet = transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+			Retries:  &retries,
+			Timeout:  types.Duration(365 * 24 * time.Hour),
+		},
+	})
+	expectForward(packet, time.Hour)
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr))
+	inFlightPacket, found = setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.True(t, found)
+	require.Equal(t, int32(2), inFlightPacket.RetriesRemaining)
+
+	// forwards are retried once per failover channel, within the limits.
+	failoverPacket := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+			Failover: []*types.FailoverChannel{{Channel: "channel-1"}, {Channel: "channel-2"}, {Channel: "channel-3"}},
+		},
+	})
+	clientState := &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1000)}
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).Return(channeltypes.Channel{State: channeltypes.OPEN}, true)
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, channel).Return("07-tendermint-0", clientState, nil)
+	setup.Mocks.ClientKeeperMock.EXPECT().GetClientStatus(ctx, clientState, "07-tendermint-0").Return(ibcexported.Active)
+	expectForward(failoverPacket, 30*time.Minute)
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, failoverPacket, senderAccAddr))
+	inFlightPacket, found = setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.True(t, found)
+	require.Equal(t, int32(2), inFlightPacket.RetriesRemaining)
+
+	// or rejected before any funds move.
+	params.ForwardLimits.Behavior = types.ForwardLimitBehaviorReject
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+	ack := forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+	require.False(t, ack.Success())
+	ack = forwardMiddleware.OnRecvPacket(ctx, failoverPacket, senderAccAddr)
+	require.False(t, ack.Success())
+}
+
+func TestOnRecvPacket_ForwardMetadataLimits(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+	)
+	senderAccAddr := test.AccAddress()
+
+	params := types.DefaultParams()
+	params.MaxForwardHops = 2
+	params.MaxMemoSize = 512
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+	// routes longer than the maximum number of hops are rejected before the underlying app moves any funds.
+	hops := make([]*types.PathHop, 3)
+	for i := range hops {
+		hops[i] = &types.PathHop{Receiver: destAddr, Channel: fmt.Sprintf("channel-%d", i)}
+	}
+	packet := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{Path: hops},
+	})
+	ack := forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "ABCI code: 9")
+
+	// as are memos larger than the maximum memo size.
+	packet = transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver:       destAddr,
+			Port:           port,
+			Channel:        "channel-0",
+			RecoverAddress: strings.Repeat("a", 512),
+		},
+	})
+	ack = forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "ABCI code: 9")
+
+	// oversized memos are rejected without being decoded, even if they are not valid JSON.
+	packet = transferPacket(t, hostAddr, `{"forward":{"receiver":"`+strings.Repeat("a", 512))
+	ack = forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "ABCI code: 9")
+
+	// oversized memos without forward metadata, and memos whose keys only match forward metadata keys
+	// case-insensitively, are passed on to the underlying app.
+	passThrough := []channeltypes.Packet{
+		transferPacket(t, hostAddr, `{"wasm":{"msg":"`+strings.Repeat("a", 512)+`"}}`),
+		transferPacket(t, hostAddr, `{"Forward":{"receiver":"`+destAddr+`","port":"transfer","channel":"channel-0"}}`),
+	}
+	for _, packet := range passThrough {
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+		ack = forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+		require.True(t, ack.Success())
+	}
+}
+
+func TestOnRecvPacket_ForwardUnwind(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr         = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		intermediateAddr = "juno1quyqjzstpsxsurcszyfpx9q4zct3sxg67q9dka"
+		destAddr         = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+	senderAccAddr := test.AccAddress()
+
+	// the token was sent to the chain the packet comes from over channel-5 of its origin chain, and the packet
+	// carries it one hop further.
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver:        destAddr,
+			Unwind:          true,
+			UnwindReceivers: []string{intermediateAddr},
+		},
+	})
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(packetOrig.Data, &data))
+	data.Denom = "transfer/channel-5/" + testDenom
+	packetOrig.Data = data.GetBytes()
+
+	denom := transfertypes.ParseDenomTrace(
+		fmt.Sprintf("%s/%s/transfer/channel-5/%s", testDestinationPort, testDestinationChannel, testDenom),
+	).IBCDenom()
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		// the token is sent back over the channel it was received on, and on to its origin from there.
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				testDestinationPort,
+				testDestinationChannel,
+				sdk.NewCoin(denom, sdk.NewInt(100)),
+				hostAddr,
+				intermediateAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"channel-5"}}`, destAddr),
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+}
+
+func TestOnRecvPacket_ForwardUnwindRegisteredPrefix(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+	senderAccAddr := test.AccAddress()
+
+	// the bech32 prefix of the receiver belongs to a chain reached over another channel.
+	require.NoError(t, setup.Keepers.RouterKeeper.SetChainRoute(ctx, types.ChainRoute{
+		ChainId: "cosmoshub-4", Port: "transfer", Channel: "channel-141", Bech32Prefixes: []string{"cosmos"},
+	}))
+
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Unwind:   true,
+		},
+	})
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		// the unwind is sent back over the channel the token was received on, not resolved by the prefix.
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				testDestinationPort,
+				testDestinationChannel,
+				sdk.NewCoin(denom, sdk.NewInt(100)),
+				hostAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+}
+
+func TestOnRecvPacket_ForwardMultihopStringNext(t *testing.T) {
+	var err error
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr  = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		hostAddr2 = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
+		destAddr  = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port      = "transfer"
+		channel   = "channel-0"
+		channel2  = "channel-1"
+	)
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	senderAccAddr2 := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	nextMetadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel2,
+		},
+	}
+	nextBz, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: hostAddr2,
+			Port:     port,
+			Channel:  channel,
+			Next:     types.NewJSONObject(false, nextBz, orderedmap.OrderedMap{}),
+		},
+	})
+	packet2 := transferPacket(t, hostAddr2, nextMetadata)
+	packetFwd := transferPacket(t, destAddr, nil)
+
+	memo1, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	msgTransfer1 := transfertypes.NewMsgTransfer(
+		port,
+		channel,
+		testCoin,
+		hostAddr,
+		hostAddr2,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		string(memo1),
+	)
+
+	// no memo on final forward
+	msgTransfer2 := transfertypes.NewMsgTransfer(
+		port,
+		channel2,
+		testCoin,
+		hostAddr2,
+		destAddr,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		"",
+	)
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	successAck := cdc.MustMarshalJSON(&acknowledgement)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			msgTransfer1,
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet2, senderAccAddr2).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			msgTransfer2,
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2).
+			Return(nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr).
+			Return(nil),
+	)
+
+	// chain B with router module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// chain C with router module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack = forwardMiddleware.OnRecvPacket(ctx, packet2, senderAccAddr2)
+	require.Nil(t, ack)
+
+	// ack returned from chain D to chain C
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2)
+	require.NoError(t, err)
+
+	// ack returned from chain C to chain B
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
+	require.NoError(t, err)
+}
+
+func TestOnRecvPacket_ForwardPath(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr  = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		hostAddr2 = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
+		destAddr  = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port      = "transfer"
+		channel   = "channel-0"
+		channel2  = "channel-1"
+	)
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Path: []*types.PathHop{
+				{Receiver: hostAddr2, Channel: channel},
+				{Receiver: destAddr, Channel: channel2},
+			},
+		},
+	})
+
+	// the remaining hop is sent as a nested next memo understood by any chain running the middleware.
+	nextMemo, err := json.Marshal(&types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel2,
+		},
+	})
+	require.NoError(t, err)
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				hostAddr,
+				hostAddr2,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				string(nextMemo),
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+}
+
+func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
+	var err error
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr  = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		hostAddr2 = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
+		destAddr  = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port      = "transfer"
+		channel   = "channel-0"
+		channel2  = "channel-1"
+	)
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	senderAccAddr2 := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	nextMetadata := &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel2,
+		},
+	}
+	nextBz, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	nextJSONObject := new(types.JSONObject)
+	err = json.Unmarshal(nextBz, nextJSONObject)
+	require.NoError(t, err)
+
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: hostAddr2,
+			Port:     port,
+			Channel:  channel,
+			Next:     nextJSONObject,
+		},
+	})
+	packet2 := transferPacket(t, hostAddr2, nextMetadata)
+	packetFwd := transferPacket(t, destAddr, nil)
+
+	memo1, err := json.Marshal(nextMetadata)
+	require.NoError(t, err)
+
+	msgTransfer1 := transfertypes.NewMsgTransfer(
+		port,
+		channel,
+		testCoin,
+		hostAddr,
+		hostAddr2,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		string(memo1),
+	)
+
+	// no memo on final forward
+	msgTransfer2 := transfertypes.NewMsgTransfer(
+		port,
+		channel2,
+		testCoin,
+		hostAddr2,
+		destAddr,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		"",
+	)
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	successAck := cdc.MustMarshalJSON(&acknowledgement)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			msgTransfer1,
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet2, senderAccAddr2).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			msgTransfer2,
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2).
+			Return(nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr).
+			Return(nil),
+	)
+
+	// chain B with router module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// chain C with router module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack = forwardMiddleware.OnRecvPacket(ctx, packet2, senderAccAddr2)
+	require.Nil(t, ack)
+
+	// ack returned from chain D to chain C
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2)
+	require.NoError(t, err)
+
+	// ack returned from chain C to chain B
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
+	require.NoError(t, err)
+}
+
+func nftTransferPacket(t *testing.T, receiver string, metadata any) channeltypes.Packet {
+	t.Helper()
+	nftPacket := types.NewNonFungibleTokenPacketData(testClassID, "", "", []string{"1", "2"}, nil, nil, "", receiver, "")
+
+	if metadata != nil {
+		memo, err := json.Marshal(metadata)
+		require.NoError(t, err)
+		nftPacket.Memo = string(memo)
+	}
+
+	return channeltypes.Packet{
+		SourcePort:         testSourcePort,
+		SourceChannel:      testSourceChannel,
+		DestinationPort:    testDestinationPort,
+		DestinationChannel: testDestinationChannel,
+		Data:               nftPacket.GetBytes(),
+	}
+}
+
+func TestOnRecvPacket_ForwardNFT(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	classID := makeIBCDenom(testDestinationPort, testDestinationChannel, testClassID)
+	senderAccAddr := test.AccAddress()
+	hostAccAddr := sdk.MustAccAddressFromBech32(hostAddr)
+	packetOrig := nftTransferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	})
+	forwardData := types.NewNonFungibleTokenPacketData(classID, "", "", []string{"1", "2"}, nil, nil, hostAddr, destAddr, "")
+	packetFwd := channeltypes.Packet{
+		SourcePort:    port,
+		SourceChannel: channel,
+		Data:          forwardData.GetBytes(),
+	}
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed to receive NFT"))
+	failedAck := cdc.MustMarshalJSON(&errorAck)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.NFTTransferKeeperMock.EXPECT().SendTransfer(
+			ctx,
+			port,
+			channel,
+			classID,
+			[]string{"1", "2"},
+			hostAccAddr,
+			destAddr,
+			keeper.DefaultTransferPacketTimeoutHeight,
+			uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+			"",
+		).Return(uint64(0), nil),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return("nft-transfer", nil, nil),
+
+		setup.Mocks.NFTTransferKeeperMock.EXPECT().ClassPathFromHash(ctx, classID).
+			Return(testDestinationPort+"/"+testDestinationChannel+"/"+testClassID, nil),
+
+		// the class did not originate on this chain, so the vouchers escrowed for the forward are burned.
+		setup.Mocks.NFTTransferKeeperMock.EXPECT().GetEscrowAddress(port, channel).Return(test.AccAddress()),
+		setup.Mocks.NFTKeeperMock.EXPECT().Burn(ctx, classID, "1").Return(nil),
+		setup.Mocks.NFTKeeperMock.EXPECT().Burn(ctx, classID, "2").Return(nil),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), errorAck).
+			Return(nil),
+	)
+
+	// chain B with router module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+	requireEventEmitted(t, ctx, &types.EventForwardInitiated{})
+
+	_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.True(t, found)
+
+	// error ack returned from chain C
+	err := forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, failedAck, senderAccAddr)
+	require.NoError(t, err)
+	requireEventEmitted(t, ctx, &types.EventForwardRefunded{})
+
+	_, found = setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.False(t, found)
+}
+
+func TestOnRecvPacket_ForwardNFTUnwind(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+	senderAccAddr := test.AccAddress()
+	packetOrig := nftTransferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Unwind:   true,
+		},
+	})
+
+	// unwind is only supported for fungible tokens, so the packet is rejected before the underlying app receives it.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	var errorAck channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &errorAck))
+	require.Contains(t, errorAck.GetError(), fmt.Sprintf("ABCI code: %d", sdkerrors.ErrNotSupported.ABCICode()))
+}
+
+func TestOnTimeoutPacket_ForwardNFTRetry(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	classID := makeIBCDenom(testDestinationPort, testDestinationChannel, testClassID)
+	senderAccAddr := test.AccAddress()
+	hostAccAddr := sdk.MustAccAddressFromBech32(hostAddr)
+	retries := uint8(1)
+	packetOrig := nftTransferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+			Retries:  &retries,
+		},
+	})
+	forwardData := types.NewNonFungibleTokenPacketData(
+		testDestinationPort+"/"+testDestinationChannel+"/"+testClassID, "", "", []string{"1", "2"}, nil, nil, hostAddr, destAddr, "",
+	)
+	packetFwd := channeltypes.Packet{
+		SourcePort:    port,
+		SourceChannel: channel,
+		Data:          forwardData.GetBytes(),
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet2, senderAccAddr2).
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		setup.Mocks.NFTTransferKeeperMock.EXPECT().SendTransfer(
+			ctx, port, channel, classID, []string{"1", "2"}, hostAccAddr, destAddr,
+			keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, "",
+		).Return(uint64(0), nil),
+
+		// the ICS-721 app refunds the NFTs to the forwarder on this chain, from where they are sent again.
+		setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwd, senderAccAddr).Return(nil),
+		setup.Mocks.NFTTransferKeeperMock.EXPECT().SendTransfer(
+			ctx, port, channel, classID, []string{"1", "2"}, hostAccAddr, destAddr,
+			keeper.DefaultTransferPacketTimeoutHeight, timeoutTimestamp, "",
+		).Return(uint64(1), nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	err := forwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr)
+	require.NoError(t, err)
+	requireEventEmitted(t, ctx, &types.EventForwardRetried{})
+
+	_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.False(t, found)
+	inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 1)
+	require.True(t, found)
+	require.Equal(t, int32(0), inFlightPacket.RetriesRemaining)
+}
+
+func TestOnTimeoutPacket_ScheduledRetry(t *testing.T) {
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	hostAccAddr := sdk.MustAccAddressFromBech32(hostAddr)
+	testCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100)))
+	retries := uint8(2)
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+			Retries:  &retries,
+		},
+	})
+	packetFwd := transferPacket(t, destAddr, nil)
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
+	recvAck := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	// timeOut schedules a retry of the forward one minute after it times out.
+	timeOut := func(t *testing.T, setup *test.Setup) sdk.Context {
+		t.Helper()
+		ctx := setup.Initializer.Ctx
+		params := types.DefaultParams()
+		params.RetryBackoff = types.RetryBackoff{InitialDelay: time.Minute, Multiplier: sdk.NewDec(2)}
+		require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwd, senderAccAddr).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.RetryEscrowAddress(), testCoins).Return(nil),
+		)
+
+		require.Nil(t, setup.ForwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+		require.NoError(t, setup.ForwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr))
+		requireEventEmitted(t, ctx, &types.EventForwardRetryScheduled{})
+
+		inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+		require.True(t, found)
+		require.Equal(t, ctx.BlockTime().Add(time.Minute), *inFlightPacket.RetryTime)
+
+		// the retry is not due yet.
+		earlyCtx := ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Second))
+		router.NewAppModule(setup.Keepers.RouterKeeper, nil).EndBlock(earlyCtx, abci.RequestEndBlock{})
+
+		return ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	}
+
+	t.Run("retry sent once due", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		dueCtx := timeOut(t, setup)
+
+		// the timeout of the retry is doubled.
+		msgTransfer := transfertypes.NewMsgTransfer(
+			port, channel, testCoins[0], hostAddr, destAddr, keeper.DefaultTransferPacketTimeoutHeight,
+			uint64(dueCtx.BlockTime().UnixNano())+2*uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+			"",
+		)
+		gomock.InOrder(
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(gomock.Any(), types.RetryEscrowAddress(), hostAccAddr, testCoins).Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), msgTransfer).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+		)
+
+		router.NewAppModule(setup.Keepers.RouterKeeper, nil).EndBlock(dueCtx, abci.RequestEndBlock{})
+		requireEventEmitted(t, dueCtx, &types.EventForwardRetried{})
+
+		_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(dueCtx, channel, port, 0)
+		require.False(t, found)
+		inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(dueCtx, channel, port, 1)
+		require.True(t, found)
+		require.Nil(t, inFlightPacket.RetryTime)
+		require.Equal(t, uint32(1), inFlightPacket.RetryAttempts)
+		require.Equal(t, int32(1), inFlightPacket.RetriesRemaining)
+	})
+
+	t.Run("retries beyond the maximum per block are sent in the next block", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		dueCtx := timeOut(t, setup)
+		ctx := setup.Initializer.Ctx
+
+		// a second forward times out at the same time.
+		packetOrig2, packetFwd2 := packetOrig, packetFwd
+		packetOrig2.Sequence, packetFwd2.Sequence = 1, 1
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig2, senderAccAddr).Return(recvAck),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+			setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwd2, senderAccAddr).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.RetryEscrowAddress(), testCoins).Return(nil),
+		)
+		require.Nil(t, setup.ForwardMiddleware.OnRecvPacket(ctx, packetOrig2, senderAccAddr))
+		require.NoError(t, setup.ForwardMiddleware.OnTimeoutPacket(ctx, packetFwd2, senderAccAddr))
+
+		params := setup.Keepers.RouterKeeper.GetParams(ctx)
+		params.RetryBackoff.MaxRetriesPerBlock = 1
+		require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+		gomock.InOrder(
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(gomock.Any(), types.RetryEscrowAddress(), hostAccAddr, testCoins).Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 2}, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(gomock.Any(), types.RetryEscrowAddress(), hostAccAddr, testCoins).Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 3}, nil),
+		)
+
+		router.NewAppModule(setup.Keepers.RouterKeeper, nil).EndBlock(dueCtx, abci.RequestEndBlock{})
+		_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(dueCtx, channel, port, 0)
+		require.False(t, found)
+		inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(dueCtx, channel, port, 1)
+		require.True(t, found)
+		require.NotNil(t, inFlightPacket.RetryTime)
+
+		nextCtx := dueCtx.WithBlockTime(dueCtx.BlockTime().Add(5 * time.Second))
+		router.NewAppModule(setup.Keepers.RouterKeeper, nil).EndBlock(nextCtx, abci.RequestEndBlock{})
+		_, found = setup.Keepers.RouterKeeper.GetInFlightPacket(nextCtx, channel, port, 1)
+		require.False(t, found)
+		_, found = setup.Keepers.RouterKeeper.GetInFlightPacket(nextCtx, channel, port, 3)
+		require.True(t, found)
+	})
+
+	t.Run("failed retry gives up", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		dueCtx := timeOut(t, setup)
+
+		fullDenomPath := testDestinationPort + "/" + testDestinationChannel + "/" + testDenom
+		escrowAddr := transfertypes.GetEscrowAddress(port, channel)
+		gomock.InOrder(
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(gomock.Any(), types.RetryEscrowAddress(), hostAccAddr, testCoins).Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("channel closed")),
+
+			// the held funds are returned to the escrow of the forward and refunded as for a timed out forward.
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(gomock.Any(), denom).Return(fullDenomPath, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(gomock.Any(), types.RetryEscrowAddress(), escrowAddr, testCoins).Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(gomock.Any(), denom).Return(fullDenomPath, nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(gomock.Any(), testDestinationPort, testDestinationChannel).
+				Return(transfertypes.ModuleName, nil, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), escrowAddr, transfertypes.ModuleName, testCoins).Return(nil),
+			setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(gomock.Any(), transfertypes.ModuleName, testCoins).Return(nil),
+			setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(gomock.Any(), nil, gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		router.NewAppModule(setup.Keepers.RouterKeeper, nil).EndBlock(dueCtx, abci.RequestEndBlock{})
+		requireEventEmitted(t, dueCtx, &types.EventForwardGaveUp{})
+		requireEventEmitted(t, dueCtx, &types.EventForwardRefunded{})
+
+		_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(dueCtx, channel, port, 0)
+		require.False(t, found)
+	})
+}
+
+func TestOnAcknowledgementPacket_RetryableError(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	senderAccAddr := test.AccAddress()
+	retries := uint8(2)
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+			Retries:  &retries,
+		},
+	})
+	packetFwd := transferPacket(t, destAddr, nil)
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
+	packetRetry := packetFwd
+	packetRetry.Sequence = 1
+
+	params := types.DefaultParams()
+	params.RetryableErrorAcks = []types.RetryableErrorAck{{Code: types.ErrRateLimitExceeded.ABCICode()}}
+	require.NoError(t, setup.Keepers.RouterKeeper.SetParams(ctx, params))
+
+	retryableAck := channeltypes.NewErrorAcknowledgement(types.ErrRateLimitExceeded)
+	retryableAckBz := channeltypes.SubModuleCdc.MustMarshalJSON(&retryableAck)
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("receive disabled"))
+	errorAckBz := channeltypes.SubModuleCdc.MustMarshalJSON(&errorAck)
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		// a retryable error is refunded on this chain by the transfer app and retried.
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, retryableAckBz, senderAccAddr).Return(nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		// any other error refunds the forward.
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, transfertypes.GetEscrowAddress(port, channel),
+			transfertypes.GetEscrowAddress(testDestinationPort, testDestinationChannel), gomock.Any()).Return(nil),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), errorAck).Return(nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, retryableAckBz, senderAccAddr))
+	requireEventEmitted(t, ctx, &types.EventForwardRetried{})
+
+	inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 1)
+	require.True(t, found)
+	require.Equal(t, int32(1), inFlightPacket.RetriesRemaining)
+
+	require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetRetry, errorAckBz, senderAccAddr))
+	requireEventEmitted(t, ctx, &types.EventForwardRefunded{})
+
+	_, found = setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 1)
+	require.False(t, found)
+}
+
+func TestOnRecvPacket_ForwardFailover(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr     = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr     = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		failoverAddr = "cosmos1qnnhhgzxmv3k5q8c7y7nwgb7n8tkmgs9akk4dw"
+		port         = "transfer"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  "channel-0",
+			Failover: []*types.FailoverChannel{
+				{Receiver: failoverAddr, Channel: "channel-1"},
+				{Channel: "channel-2"},
+			},
+		},
+	})
+	packetFwd := transferPacket(t, failoverAddr, nil)
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = "channel-1"
+
+	openChannel := channeltypes.Channel{State: channeltypes.OPEN}
+	closedChannel := channeltypes.Channel{State: channeltypes.CLOSED}
+	const clientID = "07-tendermint-0"
+	clientState := &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1000)}
+	timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+	msgTransfer := transfertypes.NewMsgTransfer(
+		port, "channel-1", testCoin, hostAddr, failoverAddr, keeper.DefaultTransferPacketTimeoutHeight, timeout, "",
+	)
+	// the retry is built from the data of the timed out packet.
+	msgRetry := transfertypes.NewMsgTransfer(
+		port, "channel-2", sdk.NewCoin(testDenom, sdk.NewInt(100)), "", destAddr,
+		keeper.DefaultTransferPacketTimeoutHeight, timeout, "",
+	)
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		// the primary channel is closed, so the forward is sent over the first failover channel.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, "channel-0").Return(closedChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, "channel-1").Return(openChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, "channel-1").Return(clientID, clientState, nil),
+		setup.Mocks.ClientKeeperMock.EXPECT().GetClientStatus(ctx, clientState, clientID).Return(ibcexported.Active),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		// once it times out, it moves on to the next one.
+		setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwd, senderAccAddr).Return(nil),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, "channel-2").Return(openChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, "channel-2").Return(clientID, clientState, nil),
+		setup.Mocks.ClientKeeperMock.EXPECT().GetClientStatus(ctx, clientState, clientID).Return(ibcexported.Active),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgRetry).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, "channel-1", port, 0)
+	require.True(t, found)
+	require.Equal(t, uint32(1), inFlightPacket.ActiveCandidate)
+	require.Len(t, inFlightPacket.ChannelCandidates, 3)
+	require.Equal(t, int32(2), inFlightPacket.RetriesRemaining)
+
+	require.NoError(t, forwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr))
+	requireEventEmitted(t, ctx, &types.EventForwardRetried{})
+
+	inFlightPacket, found = setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, "channel-2", port, 0)
+	require.True(t, found)
+	require.Equal(t, uint32(2), inFlightPacket.ActiveCandidate)
+	require.Equal(t, int32(1), inFlightPacket.RetriesRemaining)
+}
+
+func TestOnRecvPacket_ForwardFailoverInactiveClient(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// Test data
+	const (
+		hostAddr     = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr     = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		failoverAddr = "cosmos1qnnhhgzxmv3k5q8c7y7nwgb7n8tkmgs9akk4dw"
+		port         = "transfer"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  "channel-0",
+			Failover: []*types.FailoverChannel{{Receiver: failoverAddr, Channel: "channel-1"}},
+		},
+	})
+
+	openChannel := channeltypes.Channel{State: channeltypes.OPEN}
+	expiredClientState := &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1000)}
+	activeClientState := &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 2000)}
+	timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+	msgTransfer := transfertypes.NewMsgTransfer(
+		port, "channel-1", testCoin, hostAddr, failoverAddr, keeper.DefaultTransferPacketTimeoutHeight, timeout, "",
+	)
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		// the primary channel is open, but its client expired, so the forward is sent over the failover channel.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, "channel-0").Return(openChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, "channel-0").
+			Return("07-tendermint-0", expiredClientState, nil),
+		setup.Mocks.ClientKeeperMock.EXPECT().GetClientStatus(ctx, expiredClientState, "07-tendermint-0").
+			Return(ibcexported.Expired),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, "channel-1").Return(openChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, "channel-1").
+			Return("07-tendermint-1", activeClientState, nil),
+		setup.Mocks.ClientKeeperMock.EXPECT().GetClientStatus(ctx, activeClientState, "07-tendermint-1").
+			Return(ibcexported.Active),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	inFlightPacket, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, "channel-1", port, 0)
+	require.True(t, found)
+	require.Equal(t, uint32(1), inFlightPacket.ActiveCandidate)
+}
+
+func TestForceRefund(t *testing.T) {
+	var err error
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+	msgServer := keeper.NewMsgServerImpl(setup.Keepers.RouterKeeper)
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	})
+	packetFwd := transferPacket(t, destAddr, nil)
+	packetFwd.SourcePort = port
+	packetFwd.SourceChannel = channel
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	escrowAddr := transfertypes.GetEscrowAddress(port, channel)
+
+	openChannel := channeltypes.Channel{State: channeltypes.OPEN}
+	const clientID = "07-tendermint-0"
+	clientState := &ibctm.ClientState{LatestHeight: clienttypes.NewHeight(1, 1000)}
+	timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
 			Return(acknowledgement),
 
 		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
 			sdk.WrapSDKContext(ctx),
-			msgTransfer2,
+			gomock.Any(),
 		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2).
+		// the forwarded packet has not timed out on chain C yet.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).Return(openChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, channel).Return(clientID, clientState, nil),
+		setup.Mocks.ClientKeeperMock.EXPECT().GetClientConsensusState(ctx, clientID, clientState.LatestHeight).
+			Return(&ibctm.ConsensusState{Timestamp: time.Unix(0, int64(timeout-1))}, true),
+
+		// the forwarded packet timed out on chain C.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).Return(openChannel, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannelClientState(ctx, port, channel).Return(clientID, clientState, nil),
+		setup.Mocks.ClientKeeperMock.EXPECT().GetClientConsensusState(ctx, clientID, clientState.LatestHeight).
+			Return(&ibctm.ConsensusState{Timestamp: time.Unix(0, int64(timeout))}, true),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).
+			Return(testDestinationPort+"/"+testDestinationChannel+"/"+testDenom, nil),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(ctx, escrowAddr, transfertypes.ModuleName, sdk.NewCoins(testCoin)).
 			Return(nil),
 
-		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr).
+		setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(testCoin)).
+			Return(nil),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), channeltypes.NewErrorAcknowledgement(types.ErrForceRefunded)).
 			Return(nil),
 	)
 
@@ -648,15 +2729,249 @@ func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
 	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
 	require.Nil(t, ack)
 
-	// chain C with router module receives packet and forwards. ack should be nil so that it is not written yet.
-	ack = forwardMiddleware.OnRecvPacket(ctx, packet2, senderAccAddr2)
-	require.Nil(t, ack)
+	// only the authority can force a refund
+	_, err = msgServer.ForceRefund(sdk.WrapSDKContext(ctx), types.NewMsgForceRefund(hostAddr, channel, port, 0))
+	require.Error(t, err)
 
-	// ack returned from chain D to chain C
-	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr2)
+	// the forwarded packet may still be received by chain C.
+	_, err = msgServer.ForceRefund(sdk.WrapSDKContext(ctx), types.NewMsgForceRefund(setup.Keepers.RouterKeeper.GetAuthority(), channel, port, 0))
+	require.ErrorIs(t, err, types.ErrForwardDeliverable)
+	_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.True(t, found)
+
+	_, err = msgServer.ForceRefund(sdk.WrapSDKContext(ctx), types.NewMsgForceRefund(setup.Keepers.RouterKeeper.GetAuthority(), channel, port, 0))
 	require.NoError(t, err)
 
-	// ack returned from chain C to chain B
-	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
+	_, found = setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.False(t, found)
+	requireEventEmitted(t, ctx, &types.EventForwardRefunded{})
+
+	// a late timeout from chain C must not refund the packet again on chain B.
+	err = forwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr)
 	require.NoError(t, err)
+
+	_, err = msgServer.ForceRefund(sdk.WrapSDKContext(ctx), types.NewMsgForceRefund(setup.Keepers.RouterKeeper.GetAuthority(), channel, port, 0))
+	require.ErrorIs(t, err, types.ErrInFlightPacketNotFound)
+}
+
+func TestForceRefund_LegacyInFlightPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+	routerKeeper := setup.Keepers.RouterKeeper
+	msgServer := keeper.NewMsgServerImpl(routerKeeper)
+
+	// Test data
+	const (
+		hostAddr = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddr = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		port     = "transfer"
+		channel  = "channel-0"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	fullDenomPath := testDestinationPort + "/" + testDestinationChannel + "/" + testDenom
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: destAddr,
+			Port:     port,
+			Channel:  channel,
+		},
+	})
+
+	timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds())
+	packetFwd := channeltypes.Packet{
+		Data:             transfertypes.NewFungibleTokenPacketData(fullDenomPath, "100", hostAddr, destAddr, "").GetBytes(),
+		SourcePort:       port,
+		SourceChannel:    channel,
+		TimeoutTimestamp: timeout,
+	}
+	commitment := channeltypes.CommitPacket(cdc, packetFwd)
+	forceRefund := func(data []byte) error {
+		msg := types.NewMsgForceRefund(routerKeeper.GetAuthority(), channel, port, 0)
+		msg.PacketData = data
+		msg.TimeoutTimestamp = timeout
+		_, err := msgServer.ForceRefund(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		// the given packet data does not match the commitment of the forwarded packet.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, port, channel, uint64(0)).Return(commitment),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, port, channel, uint64(0)).Return(commitment),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.CLOSED}, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, nil, nil),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoinsFromAccountToModule(ctx, transfertypes.GetEscrowAddress(port, channel), transfertypes.ModuleName, sdk.NewCoins(testCoin)).
+			Return(nil),
+		setup.Mocks.BankKeeperMock.EXPECT().BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(testCoin)).
+			Return(nil),
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), channeltypes.NewErrorAcknowledgement(types.ErrForceRefunded)).
+			Return(nil),
+	)
+
+	require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+	// in-flight packets forwarded before the forwarded packet was recorded do not record it.
+	genesis := routerKeeper.ExportGenesis(ctx)
+	key := string(types.RefundPacketKey(channel, port, 0))
+	inFlightPacket := genesis.InFlightPackets[key]
+	inFlightPacket.ForwardPacketData = nil
+	inFlightPacket.ForwardTimeoutHeight = ""
+	inFlightPacket.ForwardTimeoutTimestamp = 0
+	genesis.InFlightPackets[key] = inFlightPacket
+	routerKeeper.InitGenesis(ctx, *genesis)
+
+	require.ErrorIs(t, forceRefund(nil), types.ErrForwardedPacketInvalid)
+	require.ErrorIs(t, forceRefund(transfertypes.NewFungibleTokenPacketData(fullDenomPath, "1000", hostAddr, destAddr, "").GetBytes()), types.ErrForwardedPacketInvalid)
+
+	require.NoError(t, forceRefund(packetFwd.Data))
+	_, found := routerKeeper.GetInFlightPacket(ctx, channel, port, 0)
+	require.False(t, found)
+	requireEventEmitted(t, ctx, &types.EventForwardRefunded{})
+}
+
+func TestForceRefund_RefundedLocally(t *testing.T) {
+	// Test data
+	const (
+		hostAddr    = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		destAddrA   = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+		destAddrB   = "cosmos1l505zhahp24v5jsmps9vs5asah759fdce06sfp"
+		recoverAddr = "cosmos1q4p4gx889lfek5augdurrjclwtqvjhuntm6j4m"
+		port        = "transfer"
+		channelA    = "channel-0"
+		channelB    = "channel-1"
+	)
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	fullDenomPath := testDestinationPort + "/" + testDestinationChannel + "/" + testDenom
+	senderAccAddr := test.AccAddress()
+	hostAccAddr := test.AccAddressFromBech32(t, hostAddr)
+	recvAck := channeltypes.NewResultAcknowledgement([]byte("test"))
+	closedChannel := channeltypes.Channel{State: channeltypes.CLOSED}
+
+	t.Run("recover address is credited", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		forwardMiddleware := setup.ForwardMiddleware
+		msgServer := keeper.NewMsgServerImpl(setup.Keepers.RouterKeeper)
+		testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+
+		packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+			Forward: &types.ForwardMetadata{
+				Receiver:       destAddrA,
+				Port:           port,
+				Channel:        channelA,
+				RecoverAddress: recoverAddr,
+			},
+		})
+
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channelA).Return(closedChannel, true),
+
+			// the funds escrowed for the forward are refunded to the receiver on this chain, like the transfer app
+			// refunds a failed forward, and then credited to the recover address.
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).Return(fullDenomPath, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, transfertypes.GetEscrowAddress(port, channelA), hostAccAddr, sdk.NewCoins(testCoin)).
+				Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).Return(fullDenomPath, nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+				Return(transfertypes.ModuleName, nil, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, test.AccAddressFromBech32(t, recoverAddr), sdk.NewCoins(testCoin)).
+				Return(nil),
+			setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ any, _ any, ack ibcexported.Acknowledgement) error {
+					require.True(t, ack.Success())
+					return nil
+				}),
+		)
+
+		require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+		_, err := msgServer.ForceRefund(sdk.WrapSDKContext(ctx), types.NewMsgForceRefund(setup.Keepers.RouterKeeper.GetAuthority(), channelA, port, 0))
+		require.NoError(t, err)
+		requireEventEmitted(t, ctx, &types.EventForwardRecovered{})
+
+		_, found := setup.Keepers.RouterKeeper.GetInFlightPacket(ctx, channelA, port, 0)
+		require.False(t, found)
+	})
+
+	t.Run("split is settled through the split escrow", func(t *testing.T) {
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setup := test.NewTestSetup(t, ctl)
+		ctx := setup.Initializer.Ctx
+		cdc := setup.Initializer.Marshaler
+		forwardMiddleware := setup.ForwardMiddleware
+		msgServer := keeper.NewMsgServerImpl(setup.Keepers.RouterKeeper)
+		coinA := sdk.NewCoin(denom, sdk.NewInt(60))
+		coinB := sdk.NewCoin(denom, sdk.NewInt(40))
+
+		packetOrig := transferPacket(t, hostAddr, &types.PacketMetadata{
+			Splits: []*types.SplitForwardMetadata{
+				{ForwardMetadata: types.ForwardMetadata{Receiver: destAddrA, Port: port, Channel: channelA}, Amount: "60"},
+				{ForwardMetadata: types.ForwardMetadata{Receiver: destAddrB, Port: port, Channel: channelB}, Percentage: "100"},
+			},
+		})
+		packetFwdB := channeltypes.Packet{
+			SourcePort:    port,
+			SourceChannel: channelB,
+			Data:          transfertypes.NewFungibleTokenPacketData(fullDenomPath, coinB.Amount.String(), hostAddr, destAddrB, "").GetBytes(),
+		}
+
+		gomock.InOrder(
+			setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetOrig, senderAccAddr).Return(recvAck),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().Transfer(gomock.Any(), gomock.Any()).
+				Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channelA).Return(closedChannel, true),
+
+			// the first split is refunded to the receiver on this chain and held like a failed split.
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).Return(fullDenomPath, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, transfertypes.GetEscrowAddress(port, channelA), hostAccAddr, sdk.NewCoins(coinA)).
+				Return(nil),
+			setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denom).Return(fullDenomPath, nil),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, hostAccAddr, types.SplitEscrowAddress(), sdk.NewCoins(coinA)).Return(nil),
+
+			// the held funds are released once the other split succeeds.
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, types.SplitEscrowAddress(), hostAccAddr, sdk.NewCoins(coinA)).Return(nil),
+			setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+				Return(transfertypes.ModuleName, nil, nil),
+			setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, nil, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ any, _ any, ack ibcexported.Acknowledgement) error {
+					require.True(t, ack.Success())
+					return nil
+				}),
+		)
+
+		require.Nil(t, forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr))
+
+		_, err := msgServer.ForceRefund(sdk.WrapSDKContext(ctx), types.NewMsgForceRefund(setup.Keepers.RouterKeeper.GetAuthority(), channelA, port, 0))
+		require.NoError(t, err)
+		requireEventEmitted(t, ctx, &types.EventSplitForwardFailed{})
+
+		splitForward, found := setup.Keepers.RouterKeeper.GetSplitForward(ctx, testDestinationChannel, testDestinationPort, 0)
+		require.True(t, found)
+		require.Equal(t, sdk.NewCoins(coinA), splitForward.Held)
+
+		successAck := cdc.MustMarshalJSON(&recvAck)
+		require.NoError(t, forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwdB, successAck, senderAccAddr))
+		requireEventEmitted(t, ctx, &types.EventSplitForwardCompleted{})
+	})
 }
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+)
+
+// forwardSplits fans token, received by the packet, out to the next hops of its splits. Each split is tracked by
+// its own in-flight packet, and the acknowledgement of the received packet is written once all of them resolve.
+func (im IBCMiddleware) forwardSplits(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.ForwardPacketData,
+	m *types.PacketMetadata,
+	token sdk.Coin,
+	nonrefundable bool,
+) ibcexported.Acknowledgement {
+	policy, err := types.ParseSplitAckPolicy(m.SplitAckPolicy)
+	if err != nil {
+		return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), &m.Splits[0].ForwardMetadata, err)
+	}
+
+	amounts, err := types.SplitAmounts(token.Amount, m.Splits)
+	if err != nil {
+		return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), &m.Splits[0].ForwardMetadata, err)
+	}
+
+	// the events of the splits are only emitted once all of them are sent. ibc-go discards the state changes of a
+	// packet receiving an error acknowledgement, but keeps its events, which would report splits that were never sent.
+	splitsCtx := ctx.WithEventManager(sdk.NewEventManager())
+	for i, split := range m.Splits {
+		timeout, retries, err := im.forwardTimeoutAndRetries(splitsCtx, &split.ForwardMetadata)
+		if err != nil {
+			return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), &split.ForwardMetadata, err)
+		}
+
+		err = im.keeper.ForwardSplitTransferPacket(
+			splitsCtx, packet, data.GetSender(), data.GetReceiver(), &split.ForwardMetadata, sdk.NewCoin(token.Denom, amounts[i]),
+			retries, timeout, im.forwardDefaults(splitsCtx).TimeoutHeightOffset, []metrics.Label{}, nonrefundable,
+		)
+		if err != nil {
+			return im.rejectForward(ctx, packet, data.GetSender(), data.GetDenom(), data.GetAmount(), &split.ForwardMetadata, err)
+		}
+	}
+	ctx.EventManager().EmitEvents(splitsCtx.EventManager().Events())
+
+	im.keeper.SetSplitForward(
+		ctx, packet.DestinationChannel, packet.DestinationPort, packet.Sequence,
+		types.NewSplitForward(policy, data.GetReceiver(), uint32(len(m.Splits))),
+	)
+
+	// the acknowledgement is written once all splits are acknowledged or time out.
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterLegacyAminoCodec registers the router Msg types on the LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "packetforward/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgForceRefund{}, "packetforward/MsgForceRefund")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPaused{}, "packetforward/MsgSetPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgSetChainRoute{}, "packetforward/MsgSetChainRoute")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveChainRoute{}, "packetforward/MsgRemoveChainRoute")
+}
+
+// RegisterInterfaces registers the router Msg implementations with the interface registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgForceRefund{},
+		&MsgSetPaused{},
+		&MsgSetChainRoute{},
+		&MsgRemoveChainRoute{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// ReceivedDenomPath returns the full denom path, on the receiving chain, of a token with the given denom in a packet
+// received on port and channel from counterpartyPort and counterpartyChannel. The denom is unwound if the packet
+// returns the token to the chain it came from, and prefixed with port and channel otherwise.
+func ReceivedDenomPath(port, channel, counterpartyPort, counterpartyChannel, denom string) string {
+	counterpartyPrefix := transfertypes.GetDenomPrefix(counterpartyPort, counterpartyChannel)
+	if strings.HasPrefix(denom, counterpartyPrefix) {
+		return denom[len(counterpartyPrefix):]
+	}
+	return transfertypes.GetDenomPrefix(port, channel) + denom
+}
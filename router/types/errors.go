@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// router module sentinel errors
+var (
+	ErrInFlightPacketNotFound = errorsmod.Register(ModuleName, 2, "in-flight packet not found")
+	ErrForceRefunded          = errorsmod.Register(ModuleName, 3, "packet forward refunded by authority")
+	ErrForwardNotAllowed      = errorsmod.Register(ModuleName, 4, "forward not allowed by routing policy")
+	ErrRateLimitExceeded      = errorsmod.Register(ModuleName, 5, "rate limit exceeded")
+	ErrForwardingPaused       = errorsmod.Register(ModuleName, 6, "forwarding paused")
+	ErrUnknownChain           = errorsmod.Register(ModuleName, 7, "chain not found in registry")
+	ErrChainRouteConflict     = errorsmod.Register(ModuleName, 8, "chain route conflicts with registry")
+	ErrForwardLimitExceeded   = errorsmod.Register(ModuleName, 9, "forward exceeds limits")
+	ErrForwardDeliverable     = errorsmod.Register(ModuleName, 10, "forwarded packet may still be delivered")
+	ErrForwardedPacketInvalid = errorsmod.Register(ModuleName, 11, "forwarded packet does not match its commitment")
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+const (
+	// FeeRecipientCommunityPool is the recipient reported in EventFeeCollected when fees fund the community pool.
+	FeeRecipientCommunityPool = "community_pool"
+	// FeeRecipientBurn is the recipient reported in EventFeeCollected when fees are burned.
+	FeeRecipientBurn = "burn"
+)
+
+// NewOriginalPacket returns the identity of the original packet that an InFlightPacket is forwarding.
+func NewOriginalPacket(inFlightPacket *InFlightPacket) *OriginalPacket {
+	return &OriginalPacket{
+		SourcePort:         inFlightPacket.PacketSrcPortId,
+		SourceChannel:      inFlightPacket.PacketSrcChannelId,
+		DestinationPort:    inFlightPacket.RefundPortId,
+		DestinationChannel: inFlightPacket.RefundChannelId,
+		Sequence:           inFlightPacket.RefundSequence,
+		Sender:             inFlightPacket.OriginalSenderAddress,
+	}
+}
+
+// NewOriginalPacketFromPacket returns the identity of a packet received by this chain, sent by sender on the
+// chain where the transfer was initiated.
+func NewOriginalPacketFromPacket(packet channeltypes.Packet, sender string) *OriginalPacket {
+	return &OriginalPacket{
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		Sequence:           packet.Sequence,
+		Sender:             sender,
+	}
+}
+
+// NewNextHopPacket returns the identity of a packet sent from this chain to the next hop.
+func NewNextHopPacket(port, channel string, sequence uint64) *NextHopPacket {
+	return &NextHopPacket{
+		SourcePort:    port,
+		SourceChannel: channel,
+		Sequence:      sequence,
+	}
+}
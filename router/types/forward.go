@@ -1,17 +1,28 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 	"github.com/iancoleman/orderedmap"
 )
 
 type PacketMetadata struct {
 	Forward *ForwardMetadata `json:"forward"`
+
+	// Splits fans the received transfer out to several next hops instead of forwarding it to a single one.
+	Splits []*SplitForwardMetadata `json:"splits,omitempty"`
+	// SplitAckPolicy is "all_or_nothing" (the default) or "partial", see SplitAckPolicy.
+	SplitAckPolicy string `json:"split_ack_policy,omitempty"`
 }
 
 type ForwardMetadata struct {
@@ -21,70 +32,433 @@ type ForwardMetadata struct {
 	Timeout  Duration `json:"timeout,omitempty"`
 	Retries  *uint8   `json:"retries,omitempty"`
 
+	// TimeoutHeight is the timeout height of the forward on the next hop, in the format {revision}-{height}.
+	TimeoutHeight string `json:"timeout_height,omitempty"`
+
 	// Using JSONObject so that objects for next property will not be mutated by golang's lexicographic key sort on map keys during Marshal.
 	// Supports primitives for Unmarshal/Marshal so that an escaped JSON-marshaled string is also valid.
 	Next *JSONObject `json:"next,omitempty"`
+
+	// Path is a route given as a flat list of hops instead of the fields above, see ExpandPath.
+	Path []*PathHop `json:"path,omitempty"`
+
+	// RecoverAddress is an address on this chain credited with the funds if the forward fails, instead of
+	// refunding them to the chain the packet came from.
+	RecoverAddress string `json:"recover_address,omitempty"`
+
+	// Failover are alternate channels to the same next hop, which the forward moves to in order when the channel
+	// it was sent over times out, errors or is closed.
+	Failover []*FailoverChannel `json:"failover,omitempty"`
+
+	// Chain is the chain ID or an alias of the next hop in the chain registry, which is resolved to the port and
+	// channel of the forward instead of setting them.
+	Chain string `json:"chain,omitempty"`
+
+	// Unwind forwards the token back along its denom trace to the chain it is native to instead of to a port and
+	// channel, see ExpandUnwind.
+	Unwind bool `json:"unwind,omitempty"`
+
+	// UnwindReceivers are the receivers on the chains an unwind passes through before reaching the chain the token
+	// is native to, in the order it passes them. Receiver is the receiver on the chain the token is native to.
+	UnwindReceivers []string `json:"unwind_receivers,omitempty"`
+}
+
+// FailoverChannel is an alternate channel of a forward. Receiver and Port default to those of the forward.
+type FailoverChannel struct {
+	Receiver string `json:"receiver,omitempty"`
+	Port     string `json:"port,omitempty"`
+	Channel  string `json:"channel,omitempty"`
+}
+
+// PathHop is a hop of a route given as a flat path. Port defaults to the transfer port.
+type PathHop struct {
+	Receiver string   `json:"receiver,omitempty"`
+	Port     string   `json:"port,omitempty"`
+	Channel  string   `json:"channel,omitempty"`
+	Timeout  Duration `json:"timeout,omitempty"`
+	Retries  *uint8   `json:"retries,omitempty"`
+
+	TimeoutHeight  string `json:"timeout_height,omitempty"`
+	RecoverAddress string `json:"recover_address,omitempty"`
 }
 
 type Duration time.Duration
 
+// ParsePacketMetadata decodes the forward metadata of a packet memo. It returns false if the memo is not forward
+// metadata, i.e. not a JSON object with a forward or splits key, in which case the packet is not forwarded. Top level
+// keys are matched exactly and only the values of the forward metadata keys are decoded, with next memos kept as raw
+// JSON rather than decoded.
+func ParsePacketMetadata(memo string) (*PacketMetadata, bool, error) {
+	bz := []byte(memo)
+	if !isJSONObject(bz) {
+		return nil, false, nil
+	}
+	// decoding into PacketMetadata directly would also match keys such as "Forward" case-insensitively.
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &keys); err != nil {
+		return nil, false, nil
+	}
+	forward, splits := keys["forward"], keys["splits"]
+	if !isSetJSON(forward) && !isSetJSON(splits) {
+		// only memos with a forward or splits key are forwarded, others are meant for other middleware.
+		return nil, false, nil
+	}
+
+	m := &PacketMetadata{}
+	if isSetJSON(forward) {
+		if err := json.Unmarshal(forward, &m.Forward); err != nil {
+			return nil, true, err
+		}
+	}
+	if isSetJSON(splits) {
+		if err := json.Unmarshal(splits, &m.Splits); err != nil {
+			return nil, true, err
+		}
+	}
+	if policy, found := keys["split_ack_policy"]; found {
+		if err := json.Unmarshal(policy, &m.SplitAckPolicy); err != nil {
+			return nil, true, err
+		}
+	}
+	return m, true, nil
+}
+
+// MayHaveForwardKeys returns whether a memo contains a forward or splits key, without decoding it. False positives
+// are possible, e.g. for memos holding such a key in a nested object.
+func MayHaveForwardKeys(memo string) bool {
+	return strings.Contains(memo, `"forward"`) || strings.Contains(memo, `"splits"`)
+}
+
+// isSetJSON returns whether a raw JSON value is present and not null.
+func isSetJSON(raw json.RawMessage) bool {
+	return len(raw) > 0 && string(raw) != "null"
+}
+
+// isJSONObject returns whether the first non-whitespace character of bz opens a JSON object.
+func isJSONObject(bz []byte) bool {
+	bz = bytes.TrimLeft(bz, " \t\r\n")
+	return len(bz) > 0 && bz[0] == '{'
+}
+
+// Forwards validates the metadata and returns the next hops of the received transfer: the forward, or the
+// forwards of each split.
+func (m *PacketMetadata) Forwards() ([]*ForwardMetadata, error) {
+	if m.Forward != nil && len(m.Splits) > 0 {
+		return nil, fmt.Errorf("failed to validate forward metadata: forward and splits cannot both be set")
+	}
+
+	if m.Forward != nil {
+		if err := m.Forward.ExpandPath(); err != nil {
+			return nil, err
+		}
+		if err := m.Forward.Validate(); err != nil {
+			return nil, err
+		}
+		return []*ForwardMetadata{m.Forward}, nil
+	}
+
+	if len(m.Splits) == 0 {
+		return nil, fmt.Errorf("failed to validate forward metadata: forward or splits must be set")
+	}
+
+	forwards := make([]*ForwardMetadata, len(m.Splits))
+	for i, split := range m.Splits {
+		if split == nil {
+			return nil, fmt.Errorf("failed to validate forward metadata: split %d is empty", i)
+		}
+		if err := split.ExpandPath(); err != nil {
+			return nil, err
+		}
+		if err := split.Validate(); err != nil {
+			return nil, err
+		}
+		forwards[i] = &split.ForwardMetadata
+	}
+	return forwards, nil
+}
+
+// HopDepth returns the number of hops of the longest route of the metadata, counting the hops of the forwards
+// nested in next memos. Nested memos are no longer decoded once the depth exceeds limit.
+func (m *PacketMetadata) HopDepth(limit int) int {
+	depth := 0
+	if m.Forward != nil {
+		depth = m.Forward.hopDepth(limit)
+	}
+	for _, split := range m.Splits {
+		if depth > limit {
+			break
+		}
+		if split == nil {
+			continue
+		}
+		if splitDepth := split.hopDepth(limit); splitDepth > depth {
+			depth = splitDepth
+		}
+	}
+	return depth
+}
+
+// hopDepth returns the number of hops of the longest route starting with the forward.
+func (m *ForwardMetadata) hopDepth(limit int) int {
+	depth := 1
+	if len(m.Path) > 0 {
+		depth = len(m.Path)
+	}
+	if depth > limit || m.Next == nil {
+		return depth
+	}
+	next, ok := m.Next.packetMetadata()
+	if !ok {
+		// the next memo is not forward metadata, e.g. it is meant for another middleware on the next chain.
+		return depth
+	}
+	return depth + next.HopDepth(limit-depth)
+}
+
+// ExpandPath replaces a route given as a flat path with the forward to its first hop. The remaining hops are
+// re-encoded as nested next memos, followed by Next if set, so that chains that only understand next can
+// forward them.
+func (m *ForwardMetadata) ExpandPath() error {
+	if len(m.Path) == 0 {
+		return nil
+	}
+	if m.Receiver != "" || m.Port != "" || m.Channel != "" || m.Timeout != 0 || m.Retries != nil || m.TimeoutHeight != "" ||
+		m.RecoverAddress != "" || len(m.Failover) > 0 || m.Chain != "" || m.Unwind || len(m.UnwindReceivers) > 0 {
+		return fmt.Errorf(
+			"failed to validate forward metadata: path cannot be combined with receiver, port, channel, timeout, retries, " +
+				"timeout_height, recover_address, failover, chain, unwind or unwind_receivers",
+		)
+	}
+
+	hops := make([]ForwardMetadata, len(m.Path))
+	for i, hop := range m.Path {
+		if hop == nil {
+			return fmt.Errorf("failed to validate forward metadata: hop %d of path is empty", i)
+		}
+		hops[i] = ForwardMetadata{
+			Receiver: hop.Receiver,
+			Port:     hop.Port,
+			Channel:  hop.Channel,
+			Timeout:  hop.Timeout,
+			Retries:  hop.Retries,
+
+			TimeoutHeight:  hop.TimeoutHeight,
+			RecoverAddress: hop.RecoverAddress,
+		}
+		if hops[i].Port == "" {
+			hops[i].Port = transfertypes.PortID
+		}
+		if err := hops[i].Validate(); err != nil {
+			return fmt.Errorf("hop %d of path: %w", i, err)
+		}
+	}
+
+	next := m.Next
+	for i := len(hops) - 1; i > 0; i-- {
+		hop := hops[i]
+		hop.Next = next
+		bz, err := json.Marshal(PacketMetadata{Forward: &hop})
+		if err != nil {
+			return err
+		}
+		next = &JSONObject{}
+		if err := next.UnmarshalJSON(bz); err != nil {
+			return err
+		}
+	}
+
+	*m = hops[0]
+	m.Next = next
+	return nil
+}
+
+// ExpandUnwind replaces an unwind of a token with the given full denom path on this chain by a path of hops back
+// along its denom trace, which is then expanded like any other path. UnwindReceivers are the receivers of the hops
+// to the chains in between and Receiver the receiver of the last hop, while the timeout, retries, timeout height and
+// recover address apply to the first hop. Next is passed on to the chain the token is native to, so that it can
+// forward the token further.
+func (m *ForwardMetadata) ExpandUnwind(denomPath string) error {
+	if !m.Unwind {
+		return nil
+	}
+	if m.Port != "" || m.Channel != "" || len(m.Path) > 0 || m.Chain != "" || len(m.Failover) > 0 {
+		return fmt.Errorf("failed to validate forward metadata: unwind cannot be combined with port, channel, path, chain or failover")
+	}
+
+	trace := transfertypes.ParseDenomTrace(denomPath)
+	if trace.Path == "" {
+		return fmt.Errorf("failed to validate forward metadata: cannot unwind %s, which is native to this chain", denomPath)
+	}
+
+	// the trace lists the port and channel of each hop back, starting with the one on this chain.
+	identifiers := strings.Split(trace.Path, "/")
+	hops := make([]*PathHop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		hops = append(hops, &PathHop{Port: identifiers[i], Channel: identifiers[i+1]})
+	}
+	// addresses differ between chains, so the receivers on the chains in between cannot default to Receiver.
+	if len(m.UnwindReceivers) != len(hops)-1 {
+		return fmt.Errorf(
+			"failed to validate forward metadata: unwind of %s passes through %d chains, but %d unwind_receivers are set",
+			denomPath, len(hops)-1, len(m.UnwindReceivers),
+		)
+	}
+	for i, receiver := range m.UnwindReceivers {
+		hops[i].Receiver = receiver
+	}
+	hops[len(hops)-1].Receiver = m.Receiver
+	hops[0].Timeout = m.Timeout
+	hops[0].Retries = m.Retries
+	hops[0].TimeoutHeight = m.TimeoutHeight
+	hops[0].RecoverAddress = m.RecoverAddress
+
+	*m = ForwardMetadata{Path: hops, Next: m.Next}
+	return m.ExpandPath()
+}
+
 func (m *ForwardMetadata) Validate() error {
 	if m.Receiver == "" {
 		return fmt.Errorf("failed to validate forward metadata. receiver cannot be empty")
 	}
+	if m.Unwind {
+		return fmt.Errorf("failed to validate forward metadata: unwind was not expanded")
+	}
+	if len(m.UnwindReceivers) > 0 {
+		return fmt.Errorf("failed to validate forward metadata: unwind_receivers can only be set with unwind")
+	}
+	if m.Chain != "" && m.Channel == "" {
+		return fmt.Errorf("failed to validate forward metadata: chain %s was not resolved to a channel", m.Chain)
+	}
 	if err := host.PortIdentifierValidator(m.Port); err != nil {
 		return fmt.Errorf("failed to validate forward metadata: %w", err)
 	}
 	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
 		return fmt.Errorf("failed to validate forward metadata: %w", err)
 	}
+	if m.TimeoutHeight != "" {
+		if _, err := clienttypes.ParseHeight(m.TimeoutHeight); err != nil {
+			return fmt.Errorf("failed to validate forward metadata: invalid timeout height: %w", err)
+		}
+	}
+	if m.RecoverAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RecoverAddress); err != nil {
+			return fmt.Errorf("failed to validate forward metadata: invalid recover address: %w", err)
+		}
+	}
+	for i, failover := range m.Failover {
+		if failover == nil {
+			return fmt.Errorf("failed to validate forward metadata: failover channel %d is empty", i)
+		}
+		if failover.Port != "" {
+			if err := host.PortIdentifierValidator(failover.Port); err != nil {
+				return fmt.Errorf("failed to validate forward metadata: failover channel %d: %w", i, err)
+			}
+		}
+		if err := host.ChannelIdentifierValidator(failover.Channel); err != nil {
+			return fmt.Errorf("failed to validate forward metadata: failover channel %d: %w", i, err)
+		}
+	}
 
 	return nil
 }
 
+// ChannelCandidates returns the channels the forward fails over between, starting with its channel, or nil if it
+// has no failover channels.
+func (m *ForwardMetadata) ChannelCandidates() []ChannelCandidate {
+	if len(m.Failover) == 0 {
+		return nil
+	}
+
+	candidates := make([]ChannelCandidate, 0, len(m.Failover)+1)
+	candidates = append(candidates, ChannelCandidate{Port: m.Port, Channel: m.Channel, Receiver: m.Receiver})
+	for _, failover := range m.Failover {
+		candidate := ChannelCandidate{Port: failover.Port, Channel: failover.Channel, Receiver: failover.Receiver}
+		if candidate.Port == "" {
+			candidate.Port = m.Port
+		}
+		if candidate.Receiver == "" {
+			candidate.Receiver = m.Receiver
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
+
+// NextChannels returns the channels on this chain the forward may be sent over.
+func (m *ForwardMetadata) NextChannels() []PortChannel {
+	nextChannels := []PortChannel{NewPortChannel(m.Port, m.Channel)}
+	for _, candidate := range m.ChannelCandidates() {
+		if candidate.Port != m.Port || candidate.Channel != m.Channel {
+			nextChannels = append(nextChannels, NewPortChannel(candidate.Port, candidate.Channel))
+		}
+	}
+	return nextChannels
+}
+
+// IntermediateReceiver returns the address on this chain that receives a forwarded packet when the receiver is
+// derived instead of taken from the packet. It only depends on the channel the packet was received on and the
+// original sender, and no key exists for it, so the forwarded funds are never held by an address the sender chose.
+func IntermediateReceiver(channel, originalSender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(channel+"/"+originalSender))[:20])
+}
+
 // JSONObject is a wrapper type to allow either a primitive type or a JSON object.
-// In the case the value is a JSON object, OrderedMap type is used so that key order
-// is retained across Unmarshal/Marshal.
+// In the case the value is a JSON object, it is kept as raw JSON so that key order
+// is retained across Unmarshal/Marshal without decoding it.
 type JSONObject struct {
-	obj        bool
-	primitive  []byte
-	orderedMap orderedmap.OrderedMap
+	obj       bool
+	primitive []byte
+	object    json.RawMessage
 }
 
 // NewJSONObject is a constructor used for tests.
 // The usage of JSONObject in the middleware is only json Marshal/Unmarshal
 func NewJSONObject(object bool, primitive []byte, orderedMap orderedmap.OrderedMap) *JSONObject {
-	return &JSONObject{
-		obj:        object,
-		primitive:  primitive,
-		orderedMap: orderedMap,
+	o := &JSONObject{
+		obj:       object,
+		primitive: primitive,
 	}
+	if object {
+		o.object, _ = orderedMap.MarshalJSON()
+	}
+	return o
 }
 
 // UnmarshalJSON overrides the default json.Unmarshal behavior
 func (o *JSONObject) UnmarshalJSON(b []byte) error {
-	if err := o.orderedMap.UnmarshalJSON(b); err != nil {
-		// If ordered map unmarshal fails, this is a primitive value
-		o.obj = false
-		// Attempt to unmarshal as string, this removes extra JSON escaping
-		var primitiveStr string
-		if err := json.Unmarshal(b, &primitiveStr); err != nil {
-			o.primitive = b
-			return nil
-		}
-		o.primitive = []byte(primitiveStr)
+	if isJSONObject(b) {
+		// This is a JSON object, already validated by the decoder, now stored as raw JSON to retain key order.
+		// b is only valid until UnmarshalJSON returns, so it is copied.
+		o.obj = true
+		o.object = append(json.RawMessage(nil), b...)
+		return nil
+	}
+	o.obj = false
+	// Attempt to unmarshal as string, this removes extra JSON escaping
+	var primitiveStr string
+	if err := json.Unmarshal(b, &primitiveStr); err != nil {
+		o.primitive = append([]byte(nil), b...)
 		return nil
 	}
-	// This is a JSON object, now stored as an ordered map to retain key order.
-	o.obj = true
+	o.primitive = []byte(primitiveStr)
 	return nil
 }
 
+// packetMetadata decodes the next memo as packet metadata, false if it is not valid forward metadata.
+func (o *JSONObject) packetMetadata() (*PacketMetadata, bool) {
+	bz, err := o.MarshalJSON()
+	if err != nil {
+		return nil, false
+	}
+	m, ok, err := ParsePacketMetadata(string(bz))
+	return m, ok && err == nil
+}
+
 // MarshalJSON overrides the default json.Marshal behavior
 func (o JSONObject) MarshalJSON() ([]byte, error) {
 	if o.obj {
-		// non-primitive, return marshaled ordered map.
-		return o.orderedMap.MarshalJSON()
+		// non-primitive, return the raw JSON object.
+		return o.object, nil
 	}
 	// primitive, return raw bytes.
 	return o.primitive, nil
@@ -114,3 +488,10 @@ func (d *Duration) UnmarshalJSON(b []byte) error {
 		return errors.New("invalid duration")
 	}
 }
+
+// RefundedLocally returns true if a failed forward is refunded to the receiver on this chain by the transfer
+// application, before the funds are moved on by the middleware, instead of being refunded to the chain the
+// received packet came from.
+func (p *InFlightPacket) RefundedLocally() bool {
+	return p.Split || p.RecoverAddress != ""
+}
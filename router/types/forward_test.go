@@ -2,8 +2,11 @@ package types_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/iancoleman/orderedmap"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
 	"github.com/stretchr/testify/require"
 )
@@ -32,6 +35,88 @@ func TestForwardMetadataUnmarshalJSONNext(t *testing.T) {
 	require.Equal(t, `{"forward":{"receiver":"noble1l505zhahp24v5jsmps9vs5asah759fdce06sfp","port":"transfer","channel":"channel-0","timeout":0}}`, string(nextBz))
 }
 
+func TestParsePacketMetadata(t *testing.T) {
+	tests := []struct {
+		name   string
+		memo   string
+		expOK  bool
+		expErr bool
+	}{
+		{"empty", "", false, false},
+		{"plain text", "hello", false, false},
+		{"array", `[{"forward":{}}]`, false, false},
+		{"other middleware", `{"wasm":{"contract":"a"}}`, false, false},
+		{"null forward", `{"forward":null}`, false, false},
+		{"invalid json", `{"forward":{`, false, false},
+		{"invalid other key", `{"split_ack_policy":1}`, false, false},
+		{"forward", `{"forward":{"receiver":"a","port":"transfer","channel":"channel-0"}}`, true, false},
+		{"empty forward", `{"forward":{}}`, true, false},
+		{"splits", `{"splits":[{"receiver":"a","port":"transfer","channel":"channel-0","amount":"10"}]}`, true, false},
+		{"invalid forward", `{"forward":"channel-0"}`, true, true},
+		{"invalid splits", `{"splits":{},"wasm":{}}`, true, true},
+		{"capitalized forward", `{"Forward":{"receiver":"a","port":"transfer","channel":"channel-0"}}`, false, false},
+		{"upper case splits", `{"SPLITS":[{"receiver":"a","port":"transfer","channel":"channel-0","amount":"10"}]}`, false, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			m, ok, err := types.ParsePacketMetadata(tc.memo)
+			require.Equal(t, tc.expOK, ok)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if ok {
+				require.True(t, m.Forward != nil || m.Splits != nil)
+			}
+		})
+	}
+}
+
+func TestForwardMetadataRawJSONNext(t *testing.T) {
+	// the next memo is passed on as is, retaining key order and numbers that do not fit a float64.
+	const next = `{"wasm":{"msg":{"z":1,"a":12345678901234567890,"m":"\u003c\u003e"}},"forward":{"receiver":"b","channel":"channel-1"}}`
+	m, ok, err := types.ParsePacketMetadata(`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","next":` + next + `}}`)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	nextBz, err := json.Marshal(m.Forward.Next)
+	require.NoError(t, err)
+	require.Equal(t, next, string(nextBz))
+}
+
+func TestPacketMetadataHopDepth(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     string
+		expDepth int
+	}{
+		{"single hop", `{"forward":{"receiver":"a","port":"transfer","channel":"channel-0"}}`, 1},
+		{"json next", `{"forward":{"channel":"channel-0","next":{"forward":{"channel":"channel-1","next":{"forward":{"channel":"channel-2"}}}}}}`, 3},
+		{"string next", `{"forward":{"channel":"channel-0","next":"{\"forward\":{\"channel\":\"channel-1\"}}"}}`, 2},
+		{"next for another middleware", `{"forward":{"channel":"channel-0","next":{"wasm":{"contract":"a"}}}}`, 1},
+		{"path", `{"forward":{"path":[{"channel":"channel-0"},{"channel":"channel-1"}],"next":{"forward":{"channel":"channel-2"}}}}`, 3},
+		{"splits", `{"splits":[{"channel":"channel-0"},{"channel":"channel-1","next":{"forward":{"channel":"channel-2"}}}]}`, 2},
+		{"nested splits", `{"forward":{"channel":"channel-0","next":{"splits":[{"channel":"channel-1"},{"channel":"channel-2"}]}}}`, 2},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var packetMetadata types.PacketMetadata
+			require.NoError(t, json.Unmarshal([]byte(tc.memo), &packetMetadata))
+			require.Equal(t, tc.expDepth, packetMetadata.HopDepth(10))
+		})
+	}
+
+	// nested memos are not decoded past the limit.
+	var packetMetadata types.PacketMetadata
+	require.NoError(t, json.Unmarshal([]byte(tests[1].memo), &packetMetadata))
+	require.Equal(t, 2, packetMetadata.HopDepth(1))
+}
+
 func TestTimeoutUnmarshalString(t *testing.T) {
 	const memo = "{\"forward\":{\"receiver\":\"noble1f4cur2krsua2th9kkp7n0zje4stea4p9tu70u8\",\"port\":\"transfer\",\"channel\":\"channel-0\",\"timeout\":\"60s\"}}"
 	var packetMetadata types.PacketMetadata
@@ -57,3 +142,250 @@ func TestTimeoutUnmarshalJSON(t *testing.T) {
 
 	require.Equal(t, "60000000000", string(timeoutBz))
 }
+
+func TestForwardMetadataExpandPath(t *testing.T) {
+	const memo = `{"forward":{"path":[{"receiver":"cosmos1a","channel":"channel-0"},{"receiver":"cosmos1b","port":"transfer","channel":"channel-1","timeout":"10m","retries":2},{"receiver":"cosmos1c","channel":"channel-2"}],"next":{"wasm":{"contract":"cosmos1d"}}}}`
+	var packetMetadata types.PacketMetadata
+
+	require.NoError(t, json.Unmarshal([]byte(memo), &packetMetadata))
+
+	forwards, err := packetMetadata.Forwards()
+	require.NoError(t, err)
+	require.Len(t, forwards, 1)
+
+	forward := forwards[0]
+	require.Equal(t, "cosmos1a", forward.Receiver)
+	require.Equal(t, "transfer", forward.Port)
+	require.Equal(t, "channel-0", forward.Channel)
+	require.Empty(t, forward.Path)
+
+	nextBz, err := json.Marshal(forward.Next)
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"receiver":"cosmos1b","port":"transfer","channel":"channel-1","timeout":600000000000,"retries":2,"next":{"forward":{"receiver":"cosmos1c","port":"transfer","channel":"channel-2","next":{"wasm":{"contract":"cosmos1d"}}}}}}`, string(nextBz))
+}
+
+func TestForwardMetadataExpandUnwind(t *testing.T) {
+	const memo = `{"forward":{"receiver":"cosmos1a","unwind":true,"unwind_receivers":["juno1b"],"retries":2,"next":{"forward":{"receiver":"osmo1c","port":"transfer","channel":"channel-3"}}}}`
+	var packetMetadata types.PacketMetadata
+
+	require.NoError(t, json.Unmarshal([]byte(memo), &packetMetadata))
+	require.NoError(t, packetMetadata.Forward.ExpandUnwind("transfer/channel-1/transfer/channel-2/uatom"))
+
+	forwards, err := packetMetadata.Forwards()
+	require.NoError(t, err)
+	require.Len(t, forwards, 1)
+
+	// the first hop goes to the receiver on the chain in between, the second to the receiver on the origin chain.
+	forward := forwards[0]
+	require.Equal(t, "juno1b", forward.Receiver)
+	require.Equal(t, "transfer", forward.Port)
+	require.Equal(t, "channel-1", forward.Channel)
+	require.Equal(t, uint8(2), *forward.Retries)
+	require.False(t, forward.Unwind)
+	require.Empty(t, forward.UnwindReceivers)
+
+	nextBz, err := json.Marshal(forward.Next)
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-2","next":{"forward":{"receiver":"osmo1c","port":"transfer","channel":"channel-3"}}}}`, string(nextBz))
+
+	tests := []struct {
+		name      string
+		memo      string
+		denomPath string
+	}{
+		{"native denom", `{"forward":{"receiver":"cosmos1a","unwind":true}}`, "uatom"},
+		{"unwind with channel", `{"forward":{"receiver":"cosmos1a","channel":"channel-0","unwind":true}}`, "transfer/channel-1/uatom"},
+		{"unwind without receiver", `{"forward":{"unwind":true}}`, "transfer/channel-1/uatom"},
+		{"missing unwind receivers", `{"forward":{"receiver":"cosmos1a","unwind":true}}`, "transfer/channel-1/transfer/channel-2/uatom"},
+		{"extra unwind receivers", `{"forward":{"receiver":"cosmos1a","unwind":true,"unwind_receivers":["juno1b"]}}`, "transfer/channel-1/uatom"},
+		{"empty unwind receiver", `{"forward":{"receiver":"cosmos1a","unwind":true,"unwind_receivers":[""]}}`, "transfer/channel-1/transfer/channel-2/uatom"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var packetMetadata types.PacketMetadata
+			require.NoError(t, json.Unmarshal([]byte(tc.memo), &packetMetadata))
+
+			require.Error(t, packetMetadata.Forward.ExpandUnwind(tc.denomPath))
+		})
+	}
+
+	// an unwind that was not expanded is not valid, nor are unwind receivers without an unwind.
+	require.NoError(t, json.Unmarshal([]byte(`{"forward":{"receiver":"cosmos1a","unwind":true}}`), &packetMetadata))
+	_, err = packetMetadata.Forwards()
+	require.Error(t, err)
+
+	packetMetadata = types.PacketMetadata{}
+	require.NoError(t, json.Unmarshal([]byte(`{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","unwind_receivers":["juno1b"]}}`), &packetMetadata))
+	_, err = packetMetadata.Forwards()
+	require.Error(t, err)
+}
+
+func TestForwardMetadataExpandPathInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		memo string
+	}{
+		{"path with channel", `{"forward":{"channel":"channel-0","path":[{"receiver":"cosmos1a","channel":"channel-0"}]}}`},
+		{"hop without receiver", `{"forward":{"path":[{"receiver":"cosmos1a","channel":"channel-0"},{"channel":"channel-1"}]}}`},
+		{"hop with invalid channel", `{"forward":{"path":[{"receiver":"cosmos1a","channel":"c"}]}}`},
+		{"path with timeout height", `{"forward":{"timeout_height":"1-100","path":[{"receiver":"cosmos1a","channel":"channel-0"}]}}`},
+		{"invalid timeout height", `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","timeout_height":"100"}}`},
+		{"hop with invalid timeout height", `{"forward":{"path":[{"receiver":"cosmos1a","channel":"channel-0","timeout_height":"1-"}]}}`},
+		{"path with failover", `{"forward":{"failover":[{"channel":"channel-1"}],"path":[{"receiver":"cosmos1a","channel":"channel-0"}]}}`},
+		{"failover without channel", `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","failover":[{"receiver":"cosmos1b"}]}}`},
+		{"empty failover", `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","failover":[null]}}`},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var packetMetadata types.PacketMetadata
+			require.NoError(t, json.Unmarshal([]byte(tc.memo), &packetMetadata))
+
+			_, err := packetMetadata.Forwards()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestForwardMetadataChannelCandidates(t *testing.T) {
+	const memo = `{"forward":{"receiver":"cosmos1a","port":"transfer","channel":"channel-0","failover":[{"channel":"channel-1"},{"receiver":"cosmos1b","port":"wasm.cosmos1c","channel":"channel-2"},{"channel":"channel-0"}]}}`
+	var packetMetadata types.PacketMetadata
+
+	require.NoError(t, json.Unmarshal([]byte(memo), &packetMetadata))
+
+	forwards, err := packetMetadata.Forwards()
+	require.NoError(t, err)
+	require.Len(t, forwards, 1)
+
+	require.Equal(t, []types.ChannelCandidate{
+		{Port: "transfer", Channel: "channel-0", Receiver: "cosmos1a"},
+		{Port: "transfer", Channel: "channel-1", Receiver: "cosmos1a"},
+		{Port: "wasm.cosmos1c", Channel: "channel-2", Receiver: "cosmos1b"},
+		{Port: "transfer", Channel: "channel-0", Receiver: "cosmos1a"},
+	}, forwards[0].ChannelCandidates())
+	require.Equal(t, []types.PortChannel{
+		types.NewPortChannel("transfer", "channel-0"),
+		types.NewPortChannel("transfer", "channel-1"),
+		types.NewPortChannel("wasm.cosmos1c", "channel-2"),
+	}, forwards[0].NextChannels())
+
+	forwards[0].Failover = nil
+	require.Nil(t, forwards[0].ChannelCandidates())
+}
+
+// legacyJSONObject is JSONObject as it was before next memos were kept as raw JSON, decoding JSON objects into an
+// ordered map. It is only kept to benchmark memo parsing against.
+type legacyJSONObject struct {
+	obj        bool
+	primitive  []byte
+	orderedMap orderedmap.OrderedMap
+}
+
+func (o *legacyJSONObject) UnmarshalJSON(b []byte) error {
+	if err := o.orderedMap.UnmarshalJSON(b); err != nil {
+		o.obj = false
+		var primitiveStr string
+		if err := json.Unmarshal(b, &primitiveStr); err != nil {
+			o.primitive = b
+			return nil
+		}
+		o.primitive = []byte(primitiveStr)
+		return nil
+	}
+	o.obj = true
+	return nil
+}
+
+func (o legacyJSONObject) MarshalJSON() ([]byte, error) {
+	if o.obj {
+		return o.orderedMap.MarshalJSON()
+	}
+	return o.primitive, nil
+}
+
+type legacyForwardMetadata struct {
+	Receiver string            `json:"receiver,omitempty"`
+	Port     string            `json:"port,omitempty"`
+	Channel  string            `json:"channel,omitempty"`
+	Timeout  types.Duration    `json:"timeout,omitempty"`
+	Retries  *uint8            `json:"retries,omitempty"`
+	Next     *legacyJSONObject `json:"next,omitempty"`
+}
+
+type legacyPacketMetadata struct {
+	Forward *legacyForwardMetadata `json:"forward"`
+}
+
+// legacyParsePacketMetadata parses a memo the way OnRecvPacket did before ParsePacketMetadata, into a generic map to
+// check for forward metadata and then again into the forward metadata.
+func legacyParsePacketMetadata(memo string) (*legacyPacketMetadata, bool, error) {
+	d := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(memo), &d); err != nil || (d["forward"] == nil && d["splits"] == nil) {
+		return nil, false, nil
+	}
+	m := &legacyPacketMetadata{}
+	if err := json.Unmarshal([]byte(memo), m); err != nil {
+		return nil, true, err
+	}
+	return m, true, nil
+}
+
+// benchmarkMemos returns large memos: one for another middleware, and one forwarding over several hops with a large
+// memo for the final chain.
+func benchmarkMemos() map[string]string {
+	values := make([]string, 512)
+	for i := range values {
+		values[i] = fmt.Sprintf(`{"key":"key-%d","value":%d,"data":"%s"}`, i, i, strings.Repeat("x", 32))
+	}
+	payload := `{"wasm":{"contract":"cosmos1contract","msg":{"execute":{"values":[` + strings.Join(values, ",") + `]}}}}`
+
+	forward := payload
+	for i := 4; i >= 0; i-- {
+		forward = fmt.Sprintf(`{"forward":{"receiver":"cosmos1receiver%d","port":"transfer","channel":"channel-%d","timeout":"10m","retries":2,"next":%s}}`, i, i, forward)
+	}
+
+	return map[string]string{
+		"other_middleware": payload,
+		"forward":          forward,
+	}
+}
+
+func BenchmarkParsePacketMetadata(b *testing.B) {
+	for name, memo := range benchmarkMemos() {
+		memo := memo
+		b.Run(name+"/legacy", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(memo)))
+			for i := 0; i < b.N; i++ {
+				m, ok, err := legacyParsePacketMetadata(memo)
+				if err != nil {
+					b.Fatal(err)
+				}
+				// the next memo is re-encoded when the packet is forwarded.
+				if ok {
+					if _, err := json.Marshal(m.Forward.Next); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(name+"/single_pass", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(memo)))
+			for i := 0; i < b.N; i++ {
+				m, ok, err := types.ParsePacketMetadata(memo)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if ok {
+					if _, err := json.Marshal(m.Forward.Next); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a 29-fee GenesisState instance.
 func NewGenesisState(params Params, inFlightPackets map[string]InFlightPacket) *GenesisState {
 	return &GenesisState{
@@ -19,5 +21,29 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	for _, scope := range gs.Paused {
+		if err := scope.Validate(); err != nil {
+			return fmt.Errorf("invalid paused scope: %w", err)
+		}
+	}
+	for key, splitForward := range gs.SplitForwards {
+		if _, _, _, err := ParseRefundPacketKey([]byte(key)); err != nil {
+			return fmt.Errorf("invalid split forward key: %w", err)
+		}
+		if err := splitForward.Held.Validate(); err != nil {
+			return fmt.Errorf("invalid split forward %s: %w", key, err)
+		}
+	}
+	if err := ValidateChainRoutes(gs.ChainRoutes); err != nil {
+		return fmt.Errorf("invalid chain route: %w", err)
+	}
+	for _, key := range gs.ForceRefundedPackets {
+		if _, _, _, err := ParseRefundPacketKey([]byte(key)); err != nil {
+			return fmt.Errorf("invalid force refunded packet key: %w", err)
+		}
+	}
+	return nil
 }
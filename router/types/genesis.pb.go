@@ -6,17 +6,23 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +30,139 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ForwardLimitBehavior enumerates how forwards whose memo sets retries or a
+// timeout outside the forward limits are handled.
+type ForwardLimitBehavior int32
+
+const (
+	// FORWARD_LIMIT_BEHAVIOR_CLAMP clamps the retries and timeout to the limits.
+	ForwardLimitBehaviorClamp ForwardLimitBehavior = 0
+	// FORWARD_LIMIT_BEHAVIOR_REJECT rejects the packet with an error
+	// acknowledgement, refunding the sender.
+	ForwardLimitBehaviorReject ForwardLimitBehavior = 1
+)
+
+var ForwardLimitBehavior_name = map[int32]string{
+	0: "FORWARD_LIMIT_BEHAVIOR_CLAMP",
+	1: "FORWARD_LIMIT_BEHAVIOR_REJECT",
+}
+
+var ForwardLimitBehavior_value = map[string]int32{
+	"FORWARD_LIMIT_BEHAVIOR_CLAMP":  0,
+	"FORWARD_LIMIT_BEHAVIOR_REJECT": 1,
+}
+
+func (x ForwardLimitBehavior) String() string {
+	return proto.EnumName(ForwardLimitBehavior_name, int32(x))
+}
+
+func (ForwardLimitBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{0}
+}
+
+// PausedForwardBehavior enumerates how received packets are handled when
+// forwarding is paused for them.
+type PausedForwardBehavior int32
+
+const (
+	// PAUSED_FORWARD_BEHAVIOR_ERROR_ACK rejects the packet with an error
+	// acknowledgement, refunding the sender.
+	PausedForwardBehaviorErrorAck PausedForwardBehavior = 0
+	// PAUSED_FORWARD_BEHAVIOR_PASS_THROUGH passes the packet to the underlying
+	// application without forwarding it, crediting the funds to the receiver on
+	// this chain.
+	PausedForwardBehaviorPassThrough PausedForwardBehavior = 1
+)
+
+var PausedForwardBehavior_name = map[int32]string{
+	0: "PAUSED_FORWARD_BEHAVIOR_ERROR_ACK",
+	1: "PAUSED_FORWARD_BEHAVIOR_PASS_THROUGH",
+}
+
+var PausedForwardBehavior_value = map[string]int32{
+	"PAUSED_FORWARD_BEHAVIOR_ERROR_ACK":    0,
+	"PAUSED_FORWARD_BEHAVIOR_PASS_THROUGH": 1,
+}
+
+func (x PausedForwardBehavior) String() string {
+	return proto.EnumName(PausedForwardBehavior_name, int32(x))
+}
+
+func (PausedForwardBehavior) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{1}
+}
+
+// FeeRecipientType enumerates the kinds of fee destinations.
+type FeeRecipientType int32
+
+const (
+	// FEE_RECIPIENT_TYPE_COMMUNITY_POOL funds the community pool through the
+	// distribution module.
+	FeeRecipientTypeCommunityPool FeeRecipientType = 0
+	// FEE_RECIPIENT_TYPE_MODULE_ACCOUNT sends fees to a named module account.
+	FeeRecipientTypeModuleAccount FeeRecipientType = 1
+	// FEE_RECIPIENT_TYPE_ADDRESS sends fees to a fixed account address.
+	FeeRecipientTypeAddress FeeRecipientType = 2
+	// FEE_RECIPIENT_TYPE_BURN burns fees.
+	FeeRecipientTypeBurn FeeRecipientType = 3
+)
+
+var FeeRecipientType_name = map[int32]string{
+	0: "FEE_RECIPIENT_TYPE_COMMUNITY_POOL",
+	1: "FEE_RECIPIENT_TYPE_MODULE_ACCOUNT",
+	2: "FEE_RECIPIENT_TYPE_ADDRESS",
+	3: "FEE_RECIPIENT_TYPE_BURN",
+}
+
+var FeeRecipientType_value = map[string]int32{
+	"FEE_RECIPIENT_TYPE_COMMUNITY_POOL": 0,
+	"FEE_RECIPIENT_TYPE_MODULE_ACCOUNT": 1,
+	"FEE_RECIPIENT_TYPE_ADDRESS":        2,
+	"FEE_RECIPIENT_TYPE_BURN":           3,
+}
+
+func (x FeeRecipientType) String() string {
+	return proto.EnumName(FeeRecipientType_name, int32(x))
+}
+
+func (FeeRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{2}
+}
+
+// SplitAckPolicy selects the acknowledgement written for a received packet
+// fanned out to several next hops once all of them resolve.
+type SplitAckPolicy int32
+
+const (
+	// SPLIT_ACK_POLICY_ALL_OR_NOTHING refunds the received packet with an error
+	// acknowledgement if every split fails. Transfers to next hops that
+	// succeeded cannot be reversed, so once a split succeeds the funds of failed
+	// splits remain with the receiver on this chain and a successful
+	// acknowledgement is written.
+	SplitAckPolicyAllOrNothing SplitAckPolicy = 0
+	// SPLIT_ACK_POLICY_PARTIAL always writes a successful acknowledgement, and
+	// the funds of failed splits remain with the receiver on this chain.
+	SplitAckPolicyPartial SplitAckPolicy = 1
+)
+
+var SplitAckPolicy_name = map[int32]string{
+	0: "SPLIT_ACK_POLICY_ALL_OR_NOTHING",
+	1: "SPLIT_ACK_POLICY_PARTIAL",
+}
+
+var SplitAckPolicy_value = map[string]int32{
+	"SPLIT_ACK_POLICY_ALL_OR_NOTHING": 0,
+	"SPLIT_ACK_POLICY_PARTIAL":        1,
+}
+
+func (x SplitAckPolicy) String() string {
+	return proto.EnumName(SplitAckPolicy_name, int32(x))
+}
+
+func (SplitAckPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{3}
+}
+
 // GenesisState defines the router genesis state
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -32,6 +171,18 @@ type GenesisState struct {
 	// information about original packet for refunding if necessary: retries,
 	// srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
 	InFlightPackets map[string]InFlightPacket `protobuf:"bytes,2,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// paused are the scopes forwarding is paused for by the authority.
+	Paused []PauseScope `protobuf:"bytes,3,rep,name=paused,proto3" json:"paused"`
+	// split_forwards are the received packets fanned out to several next hops
+	// that have not been acknowledged yet, keyed by the refund channel, refund
+	// port and sequence of the received packet.
+	SplitForwards map[string]SplitForward `protobuf:"bytes,4,rep,name=split_forwards,json=splitForwards,proto3" json:"split_forwards" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// chain_routes are the routes of the chain registry.
+	ChainRoutes []ChainRoute `protobuf:"bytes,5,rep,name=chain_routes,json=chainRoutes,proto3" json:"chain_routes"`
+	// force_refunded_packets are the keys, in the same format as those of
+	// in_flight_packets, of forwarded packets refunded by the authority whose
+	// acknowledgement or timeout has not been received yet.
+	ForceRefundedPackets []string `protobuf:"bytes,6,rep,name=force_refunded_packets,json=forceRefundedPackets,proto3" json:"force_refunded_packets,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -81,23 +232,63 @@ func (m *GenesisState) GetInFlightPackets() map[string]InFlightPacket {
 	return nil
 }
 
-// Params defines the set of IBC router parameters.
-type Params struct {
-	FeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee_percentage,json=feePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_percentage" yaml:"fee_percentage"`
+func (m *GenesisState) GetPaused() []PauseScope {
+	if m != nil {
+		return m.Paused
+	}
+	return nil
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *GenesisState) GetSplitForwards() map[string]SplitForward {
+	if m != nil {
+		return m.SplitForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainRoutes() []ChainRoute {
+	if m != nil {
+		return m.ChainRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForceRefundedPackets() []string {
+	if m != nil {
+		return m.ForceRefundedPackets
+	}
+	return nil
+}
+
+// ChainRoute is an entry of the chain registry, which lets forward metadata
+// target a chain by its chain ID or an alias instead of a channel ID.
+type ChainRoute struct {
+	// chain_id is the chain ID of the destination chain.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// port is the port on this chain that forwards to the chain are sent over.
+	Port string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	// channel is the channel on this chain that forwards to the chain are sent
+	// over.
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// aliases are alternate names the chain can be targeted by.
+	Aliases []string `protobuf:"bytes,4,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// bech32_prefixes are the bech32 prefixes of account addresses on the
+	// chain, used to resolve the chain of a receiver.
+	Bech32Prefixes []string `protobuf:"bytes,5,rep,name=bech32_prefixes,json=bech32Prefixes,proto3" json:"bech32_prefixes,omitempty"`
+}
+
+func (m *ChainRoute) Reset()         { *m = ChainRoute{} }
+func (m *ChainRoute) String() string { return proto.CompactTextString(m) }
+func (*ChainRoute) ProtoMessage()    {}
+func (*ChainRoute) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4940b763c55c4e0b, []int{1}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *ChainRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChainRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChainRoute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -107,47 +298,75 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *ChainRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRoute.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *ChainRoute) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *ChainRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRoute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_ChainRoute proto.InternalMessageInfo
 
-// InFlightPacket contains information about original packet for
-// writing the acknowledgement and refunding if necessary.
-type InFlightPacket struct {
-	OriginalSenderAddress  string `protobuf:"bytes,1,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
-	RefundChannelId        string `protobuf:"bytes,2,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
-	RefundPortId           string `protobuf:"bytes,3,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
-	PacketSrcChannelId     string `protobuf:"bytes,4,opt,name=packet_src_channel_id,json=packetSrcChannelId,proto3" json:"packet_src_channel_id,omitempty"`
-	PacketSrcPortId        string `protobuf:"bytes,5,opt,name=packet_src_port_id,json=packetSrcPortId,proto3" json:"packet_src_port_id,omitempty"`
-	PacketTimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=packet_timeout_timestamp,json=packetTimeoutTimestamp,proto3" json:"packet_timeout_timestamp,omitempty"`
-	PacketTimeoutHeight    string `protobuf:"bytes,7,opt,name=packet_timeout_height,json=packetTimeoutHeight,proto3" json:"packet_timeout_height,omitempty"`
-	PacketData             []byte `protobuf:"bytes,8,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
-	RefundSequence         uint64 `protobuf:"varint,9,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
-	RetriesRemaining       int32  `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
-	Timeout                uint64 `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Nonrefundable          bool   `protobuf:"varint,12,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
+func (m *ChainRoute) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
-func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
-func (*InFlightPacket) ProtoMessage()    {}
-func (*InFlightPacket) Descriptor() ([]byte, []int) {
+func (m *ChainRoute) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *ChainRoute) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChainRoute) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *ChainRoute) GetBech32Prefixes() []string {
+	if m != nil {
+		return m.Bech32Prefixes
+	}
+	return nil
+}
+
+// PauseScope identifies forwards paused by the authority. An empty scope
+// pauses all forwards. A port and channel pause forwards received on or sent
+// to the channel. A denom pauses forwards of tokens with the denom as either
+// their base denom or their denom on this chain.
+type PauseScope struct {
+	Port    string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *PauseScope) Reset()         { *m = PauseScope{} }
+func (m *PauseScope) String() string { return proto.CompactTextString(m) }
+func (*PauseScope) ProtoMessage()    {}
+func (*PauseScope) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4940b763c55c4e0b, []int{2}
 }
-func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+func (m *PauseScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PauseScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PauseScope.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -157,480 +376,7540 @@ func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *InFlightPacket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InFlightPacket.Merge(m, src)
+func (m *PauseScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseScope.Merge(m, src)
 }
-func (m *InFlightPacket) XXX_Size() int {
+func (m *PauseScope) XXX_Size() int {
 	return m.Size()
 }
-func (m *InFlightPacket) XXX_DiscardUnknown() {
-	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+func (m *PauseScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseScope.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+var xxx_messageInfo_PauseScope proto.InternalMessageInfo
 
-func (m *InFlightPacket) GetOriginalSenderAddress() string {
+func (m *PauseScope) GetPort() string {
 	if m != nil {
-		return m.OriginalSenderAddress
+		return m.Port
 	}
 	return ""
 }
 
-func (m *InFlightPacket) GetRefundChannelId() string {
+func (m *PauseScope) GetChannel() string {
 	if m != nil {
-		return m.RefundChannelId
+		return m.Channel
 	}
 	return ""
 }
 
-func (m *InFlightPacket) GetRefundPortId() string {
+func (m *PauseScope) GetDenom() string {
 	if m != nil {
-		return m.RefundPortId
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *InFlightPacket) GetPacketSrcChannelId() string {
-	if m != nil {
-		return m.PacketSrcChannelId
+// Params defines the set of IBC router parameters.
+type Params struct {
+	// fee_percentage is the fee charged for forwards that do not match an entry
+	// of the fee schedule.
+	FeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee_percentage,json=feePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_percentage" yaml:"fee_percentage"`
+	// fee_schedule overrides the fee percentage for forwards to specific
+	// destination channels and/or of specific base denoms.
+	FeeSchedule []FeeScheduleEntry `protobuf:"bytes,2,rep,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule" yaml:"fee_schedule"`
+	// fee_recipient selects where forwarding fees are sent.
+	FeeRecipient FeeRecipient `protobuf:"bytes,3,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient" yaml:"fee_recipient"`
+	// routing_policy restricts the channels and denoms that may be forwarded.
+	RoutingPolicy RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy" yaml:"routing_policy"`
+	// rate_limits are quotas on the volume forwarded through channels of this
+	// chain.
+	RateLimits []RateLimit `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	// paused_forward_behavior selects how packets are handled when forwarding
+	// is paused for them.
+	PausedForwardBehavior PausedForwardBehavior `protobuf:"varint,6,opt,name=paused_forward_behavior,json=pausedForwardBehavior,proto3,enum=router.v1.PausedForwardBehavior" json:"paused_forward_behavior,omitempty" yaml:"paused_forward_behavior"`
+	// retry_backoff delays the retries of timed out forwards.
+	RetryBackoff RetryBackoff `protobuf:"bytes,7,opt,name=retry_backoff,json=retryBackoff,proto3" json:"retry_backoff" yaml:"retry_backoff"`
+	// retryable_error_acks are the error acknowledgements of forwards that are
+	// retried like timeouts instead of being refunded.
+	RetryableErrorAcks []RetryableErrorAck `protobuf:"bytes,8,rep,name=retryable_error_acks,json=retryableErrorAcks,proto3" json:"retryable_error_acks" yaml:"retryable_error_acks"`
+	// derive_intermediate_receiver replaces the receiver of forwarded packets on
+	// this chain with an address derived from the channel the packet was
+	// received on and its original sender, ignoring the receiver set by the
+	// sender.
+	DeriveIntermediateReceiver bool `protobuf:"varint,9,opt,name=derive_intermediate_receiver,json=deriveIntermediateReceiver,proto3" json:"derive_intermediate_receiver,omitempty" yaml:"derive_intermediate_receiver"`
+	// forward_defaults replaces the retries and timeouts the middleware was
+	// created with for forwards that do not set their own.
+	ForwardDefaults *ForwardDefaults `protobuf:"bytes,10,opt,name=forward_defaults,json=forwardDefaults,proto3" json:"forward_defaults,omitempty" yaml:"forward_defaults"`
+	// forward_limits bounds the retries and timeout of forwards.
+	ForwardLimits *ForwardLimits `protobuf:"bytes,11,opt,name=forward_limits,json=forwardLimits,proto3" json:"forward_limits,omitempty" yaml:"forward_limits"`
+	// max_memo_size is the maximum size in bytes of the memo of received
+	// packets to forward. Zero for no maximum.
+	MaxMemoSize uint64 `protobuf:"varint,12,opt,name=max_memo_size,json=maxMemoSize,proto3" json:"max_memo_size,omitempty" yaml:"max_memo_size"`
+	// max_forward_hops is the maximum number of hops of the route of received
+	// packets to forward, counting the hops of the forwards nested in next
+	// memos. Zero for no maximum.
+	MaxForwardHops uint32 `protobuf:"varint,13,opt,name=max_forward_hops,json=maxForwardHops,proto3" json:"max_forward_hops,omitempty" yaml:"max_forward_hops"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *InFlightPacket) GetPacketSrcPortId() string {
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeSchedule() []FeeScheduleEntry {
 	if m != nil {
-		return m.PacketSrcPortId
+		return m.FeeSchedule
 	}
-	return ""
+	return nil
 }
 
-func (m *InFlightPacket) GetPacketTimeoutTimestamp() uint64 {
+func (m *Params) GetFeeRecipient() FeeRecipient {
 	if m != nil {
-		return m.PacketTimeoutTimestamp
+		return m.FeeRecipient
 	}
-	return 0
+	return FeeRecipient{}
 }
 
-func (m *InFlightPacket) GetPacketTimeoutHeight() string {
+func (m *Params) GetRoutingPolicy() RoutingPolicy {
 	if m != nil {
-		return m.PacketTimeoutHeight
+		return m.RoutingPolicy
 	}
-	return ""
+	return RoutingPolicy{}
 }
 
-func (m *InFlightPacket) GetPacketData() []byte {
+func (m *Params) GetRateLimits() []RateLimit {
 	if m != nil {
-		return m.PacketData
+		return m.RateLimits
 	}
 	return nil
 }
 
-func (m *InFlightPacket) GetRefundSequence() uint64 {
+func (m *Params) GetPausedForwardBehavior() PausedForwardBehavior {
 	if m != nil {
-		return m.RefundSequence
+		return m.PausedForwardBehavior
 	}
-	return 0
+	return PausedForwardBehaviorErrorAck
 }
 
-func (m *InFlightPacket) GetRetriesRemaining() int32 {
+func (m *Params) GetRetryBackoff() RetryBackoff {
 	if m != nil {
-		return m.RetriesRemaining
+		return m.RetryBackoff
 	}
-	return 0
+	return RetryBackoff{}
 }
 
-func (m *InFlightPacket) GetTimeout() uint64 {
+func (m *Params) GetRetryableErrorAcks() []RetryableErrorAck {
 	if m != nil {
-		return m.Timeout
+		return m.RetryableErrorAcks
 	}
-	return 0
+	return nil
 }
 
-func (m *InFlightPacket) GetNonrefundable() bool {
+func (m *Params) GetDeriveIntermediateReceiver() bool {
 	if m != nil {
-		return m.Nonrefundable
+		return m.DeriveIntermediateReceiver
 	}
 	return false
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "router.v1.GenesisState")
-	proto.RegisterMapType((map[string]InFlightPacket)(nil), "router.v1.GenesisState.InFlightPacketsEntry")
-	proto.RegisterType((*Params)(nil), "router.v1.Params")
-	proto.RegisterType((*InFlightPacket)(nil), "router.v1.InFlightPacket")
+func (m *Params) GetForwardDefaults() *ForwardDefaults {
+	if m != nil {
+		return m.ForwardDefaults
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("router/v1/genesis.proto", fileDescriptor_4940b763c55c4e0b) }
+func (m *Params) GetForwardLimits() *ForwardLimits {
+	if m != nil {
+		return m.ForwardLimits
+	}
+	return nil
+}
 
-var fileDescriptor_4940b763c55c4e0b = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x4f, 0xdb, 0x3e,
-	0x18, 0x6f, 0x4a, 0x29, 0xd4, 0x2d, 0x6f, 0xfe, 0xc3, 0x9f, 0x8c, 0x43, 0x5b, 0x55, 0x68, 0xab,
-	0xc6, 0x9a, 0x08, 0x26, 0x6d, 0x88, 0xdb, 0x3a, 0x36, 0xd6, 0x5b, 0x95, 0x72, 0x9a, 0x34, 0x45,
-	0x26, 0x79, 0x9a, 0x46, 0x24, 0x76, 0x66, 0x3b, 0x65, 0xdd, 0xa7, 0xd8, 0x79, 0x97, 0x7d, 0x1d,
-	0x8e, 0x1c, 0xa7, 0x1d, 0xaa, 0x09, 0xbe, 0x01, 0x9f, 0x60, 0x8a, 0x9d, 0x42, 0xab, 0xed, 0x14,
-	0xfb, 0xf9, 0xbd, 0xf8, 0xf7, 0x3c, 0xb1, 0x8c, 0x76, 0x39, 0x4b, 0x25, 0x70, 0x7b, 0x7c, 0x68,
-	0x07, 0x40, 0x41, 0x84, 0xc2, 0x4a, 0x38, 0x93, 0x0c, 0x57, 0x34, 0x60, 0x8d, 0x0f, 0xf7, 0xb6,
-	0x03, 0x16, 0x30, 0x55, 0xb5, 0xb3, 0x95, 0x26, 0xb4, 0xbe, 0x17, 0x51, 0xed, 0x4c, 0x4b, 0x06,
-	0x92, 0x48, 0xc0, 0x36, 0x2a, 0x27, 0x84, 0x93, 0x58, 0x98, 0x46, 0xd3, 0x68, 0x57, 0x8f, 0xb6,
-	0xac, 0x07, 0x0b, 0xab, 0xaf, 0x80, 0x6e, 0xe9, 0x7a, 0xda, 0x28, 0x38, 0x39, 0x0d, 0x7f, 0x45,
-	0x5b, 0x21, 0x75, 0x87, 0x51, 0x18, 0x8c, 0xa4, 0x9b, 0x10, 0xef, 0x12, 0xa4, 0x30, 0x8b, 0xcd,
-	0xa5, 0x76, 0xf5, 0xe8, 0xc5, 0x9c, 0x76, 0xfe, 0x10, 0xab, 0x47, 0xdf, 0x2b, 0x7e, 0x5f, 0xd3,
-	0xdf, 0x51, 0xc9, 0x27, 0xdd, 0x66, 0x66, 0x7b, 0x3f, 0x6d, 0x98, 0x13, 0x12, 0x47, 0x27, 0xad,
-	0xbf, 0x4c, 0x5b, 0xce, 0x46, 0xb8, 0xa8, 0xdb, 0xfb, 0x84, 0xb6, 0xff, 0x65, 0x85, 0x37, 0xd1,
-	0xd2, 0x25, 0x4c, 0x54, 0x07, 0x15, 0x27, 0x5b, 0x62, 0x1b, 0x2d, 0x8f, 0x49, 0x94, 0x82, 0x59,
-	0x54, 0x5d, 0x3d, 0x99, 0x4b, 0xb6, 0xe8, 0xe0, 0x68, 0xde, 0x49, 0xf1, 0xd8, 0x68, 0x7d, 0x41,
-	0x65, 0xdd, 0x32, 0xa6, 0x68, 0x7d, 0x08, 0xe0, 0x26, 0xc0, 0x3d, 0xa0, 0x92, 0x04, 0xa0, 0xbd,
-	0xbb, 0x67, 0x59, 0xe6, 0x5f, 0xd3, 0xc6, 0xd3, 0x20, 0x94, 0xa3, 0xf4, 0xc2, 0xf2, 0x58, 0x6c,
-	0x7b, 0x4c, 0xc4, 0x4c, 0xe4, 0x9f, 0x8e, 0xf0, 0x2f, 0x6d, 0x39, 0x49, 0x40, 0x58, 0xa7, 0xe0,
-	0xdd, 0x4f, 0x1b, 0x3b, 0xba, 0xbb, 0x45, 0xb7, 0x96, 0xb3, 0x36, 0x04, 0xe8, 0x3f, 0xee, 0x7f,
-	0x94, 0xd0, 0xfa, 0x62, 0x2e, 0xfc, 0x0a, 0xed, 0x32, 0x1e, 0x06, 0x21, 0x25, 0x91, 0x2b, 0x80,
-	0xfa, 0xc0, 0x5d, 0xe2, 0xfb, 0x1c, 0x84, 0xc8, 0xfb, 0xdc, 0x99, 0xc1, 0x03, 0x85, 0xbe, 0xd1,
-	0x20, 0x7e, 0x8e, 0xb6, 0x38, 0x0c, 0x53, 0xea, 0xbb, 0xde, 0x88, 0x50, 0x0a, 0x91, 0x1b, 0xfa,
-	0x6a, 0x0a, 0x15, 0x67, 0x43, 0x03, 0x6f, 0x75, 0xbd, 0xe7, 0xe3, 0x7d, 0xb4, 0x9e, 0x73, 0x13,
-	0xc6, 0x65, 0x46, 0x5c, 0x52, 0xc4, 0x9a, 0xae, 0xf6, 0x19, 0x97, 0x3d, 0x1f, 0x1f, 0xa2, 0x1d,
-	0xfd, 0x4b, 0x5c, 0xc1, 0xbd, 0x79, 0xd7, 0x92, 0x22, 0x63, 0x0d, 0x0e, 0xb8, 0xf7, 0x68, 0x7c,
-	0x80, 0xf0, 0x9c, 0x64, 0x66, 0xbe, 0xac, 0x53, 0x3c, 0xf0, 0x73, 0xff, 0x63, 0x64, 0xe6, 0x64,
-	0x19, 0xc6, 0xc0, 0x52, 0xfd, 0x15, 0x92, 0xc4, 0x89, 0x59, 0x6e, 0x1a, 0xed, 0x92, 0xf3, 0xbf,
-	0xc6, 0xcf, 0x35, 0x7c, 0x3e, 0x43, 0xf1, 0xd1, 0x43, 0xb2, 0x99, 0x72, 0x04, 0xd9, 0x08, 0xcd,
-	0x15, 0x75, 0xd2, 0x7f, 0x0b, 0xb2, 0x0f, 0x0a, 0xc2, 0x0d, 0x54, 0xcd, 0x35, 0x3e, 0x91, 0xc4,
-	0x5c, 0x6d, 0x1a, 0xed, 0x9a, 0x83, 0x74, 0xe9, 0x94, 0x48, 0x82, 0x9f, 0xa1, 0x7c, 0x4e, 0xae,
-	0x80, 0xcf, 0x29, 0x50, 0x0f, 0xcc, 0x8a, 0x4a, 0x91, 0xcf, 0x6a, 0x90, 0x57, 0xf1, 0x41, 0x36,
-	0x69, 0xc9, 0x43, 0x10, 0x2e, 0x87, 0x98, 0x84, 0x34, 0xa4, 0x81, 0x89, 0x9a, 0x46, 0x7b, 0xd9,
-	0xd9, 0xcc, 0x01, 0x67, 0x56, 0xc7, 0x26, 0x5a, 0xc9, 0x33, 0x9a, 0x55, 0xe5, 0x36, 0xdb, 0xe2,
-	0x7d, 0xb4, 0x46, 0x19, 0xd5, 0xde, 0xe4, 0x22, 0x02, 0xb3, 0xd6, 0x34, 0xda, 0xab, 0xce, 0x62,
-	0xb1, 0xeb, 0x5d, 0xdf, 0xd6, 0x8d, 0x9b, 0xdb, 0xba, 0xf1, 0xfb, 0xb6, 0x6e, 0x7c, 0xbb, 0xab,
-	0x17, 0x6e, 0xee, 0xea, 0x85, 0x9f, 0x77, 0xf5, 0xc2, 0xc7, 0xde, 0xdc, 0x5d, 0x14, 0x92, 0x13,
-	0x1a, 0x40, 0xc4, 0xc6, 0xd0, 0x19, 0x03, 0x95, 0x29, 0x07, 0x61, 0xeb, 0xde, 0x3a, 0x43, 0xc6,
-	0xaf, 0x08, 0xf7, 0x3b, 0x71, 0xe8, 0xfb, 0x11, 0x5c, 0x11, 0x0e, 0xf6, 0xf8, 0xb5, 0x9d, 0xbf,
-	0x24, 0xea, 0xca, 0x5e, 0x94, 0xd5, 0x23, 0xf1, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70,
-	0x2b, 0xd0, 0x6e, 0x60, 0x04, 0x00, 0x00,
+func (m *Params) GetMaxMemoSize() uint64 {
+	if m != nil {
+		return m.MaxMemoSize
+	}
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetMaxForwardHops() uint32 {
+	if m != nil {
+		return m.MaxForwardHops
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ForwardDefaults defines the retries and timeouts of forwards that do not set
+// their own in the packet memo.
+type ForwardDefaults struct {
+	// retries is the number of times a timed out forward is retried.
+	Retries uint32 `protobuf:"varint,1,opt,name=retries,proto3" json:"retries,omitempty"`
+	// timeout is the relative timeout of forwards.
+	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// timeout_height_offset is the number of blocks after the latest height of
+	// the next hop's client that forwards without a timeout height time out at.
+	// Zero for forwards to only time out on timestamp.
+	TimeoutHeightOffset uint64 `protobuf:"varint,3,opt,name=timeout_height_offset,json=timeoutHeightOffset,proto3" json:"timeout_height_offset,omitempty" yaml:"timeout_height_offset"`
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InFlightPackets) > 0 {
-		for k := range m.InFlightPackets {
-			v := m.InFlightPackets[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ForwardDefaults) Reset()         { *m = ForwardDefaults{} }
+func (m *ForwardDefaults) String() string { return proto.CompactTextString(m) }
+func (*ForwardDefaults) ProtoMessage()    {}
+func (*ForwardDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{4}
+}
+func (m *ForwardDefaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardDefaults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ForwardDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardDefaults.Merge(m, src)
+}
+func (m *ForwardDefaults) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardDefaults.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ForwardDefaults proto.InternalMessageInfo
+
+func (m *ForwardDefaults) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FeePercentage.Size()
-		i -= size
-		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+func (m *ForwardDefaults) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForwardDefaults) GetTimeoutHeightOffset() uint64 {
+	if m != nil {
+		return m.TimeoutHeightOffset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ForwardLimits bounds the retries and timeout of forwards, whether set in the
+// packet memo or defaulted.
+type ForwardLimits struct {
+	// max_retries is the maximum number of retries of a forward.
+	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty" yaml:"max_retries"`
+	// min_timeout is the minimum relative timeout of a forward.
+	MinTimeout time.Duration `protobuf:"bytes,2,opt,name=min_timeout,json=minTimeout,proto3,stdduration" json:"min_timeout" yaml:"min_timeout"`
+	// max_timeout is the maximum relative timeout of a forward. Zero for no
+	// maximum.
+	MaxTimeout time.Duration `protobuf:"bytes,3,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout" yaml:"max_timeout"`
+	// behavior selects how forwards whose memo sets retries or a timeout
+	// outside the limits are handled.
+	Behavior ForwardLimitBehavior `protobuf:"varint,4,opt,name=behavior,proto3,enum=router.v1.ForwardLimitBehavior" json:"behavior,omitempty"`
 }
 
-func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonrefundable {
-		i--
-		if m.Nonrefundable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ForwardLimits) Reset()         { *m = ForwardLimits{} }
+func (m *ForwardLimits) String() string { return proto.CompactTextString(m) }
+func (*ForwardLimits) ProtoMessage()    {}
+func (*ForwardLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{5}
+}
+func (m *ForwardLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.RetriesRemaining != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.RefundSequence != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.PacketData) > 0 {
-		i -= len(m.PacketData)
-		copy(dAtA[i:], m.PacketData)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketData)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PacketTimeoutHeight) > 0 {
-		i -= len(m.PacketTimeoutHeight)
-		copy(dAtA[i:], m.PacketTimeoutHeight)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.PacketTimeoutTimestamp != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketTimeoutTimestamp))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.PacketSrcPortId) > 0 {
-		i -= len(m.PacketSrcPortId)
-		copy(dAtA[i:], m.PacketSrcPortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcPortId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PacketSrcChannelId) > 0 {
-		i -= len(m.PacketSrcChannelId)
-		copy(dAtA[i:], m.PacketSrcChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcChannelId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RefundPortId) > 0 {
-		i -= len(m.RefundPortId)
-		copy(dAtA[i:], m.RefundPortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.RefundChannelId) > 0 {
-		i -= len(m.RefundChannelId)
-		copy(dAtA[i:], m.RefundChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ForwardLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardLimits.Merge(m, src)
+}
+func (m *ForwardLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardLimits proto.InternalMessageInfo
+
+func (m *ForwardLimits) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
 	}
-	if len(m.OriginalSenderAddress) > 0 {
-		i -= len(m.OriginalSenderAddress)
-		copy(dAtA[i:], m.OriginalSenderAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ForwardLimits) GetMinTimeout() time.Duration {
+	if m != nil {
+		return m.MinTimeout
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ForwardLimits) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ForwardLimits) GetBehavior() ForwardLimitBehavior {
+	if m != nil {
+		return m.Behavior
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.InFlightPackets) > 0 {
-		for k, v := range m.InFlightPackets {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
-			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+	return ForwardLimitBehaviorClamp
+}
+
+// RetryableErrorAck matches a class of error acknowledgements that are
+// transient on the next hop, e.g. an exceeded rate limit. ibc-go redacts the
+// error of an acknowledgement to "ABCI code: {code}: error handling packet:
+// see events for details", so the error is typically matched by its ABCI code.
+// At least one of code or contains must be set, and both must match.
+type RetryableErrorAck struct {
+	// code is the ABCI code of the error. Zero matches any code. Codes are only
+	// unique within a module, so a code matches the errors of every module
+	// registering it.
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// contains is a substring of the error of the acknowledgement. Empty
+	// matches any error. As ibc-go v7 redacts the error message, it only
+	// matches counterparties that write the error in full, e.g. running ibc-go
+	// before v7.
+	Contains string `protobuf:"bytes,2,opt,name=contains,proto3" json:"contains,omitempty"`
+}
+
+func (m *RetryableErrorAck) Reset()         { *m = RetryableErrorAck{} }
+func (m *RetryableErrorAck) String() string { return proto.CompactTextString(m) }
+func (*RetryableErrorAck) ProtoMessage()    {}
+func (*RetryableErrorAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{6}
+}
+func (m *RetryableErrorAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryableErrorAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryableErrorAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RetryableErrorAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryableErrorAck.Merge(m, src)
+}
+func (m *RetryableErrorAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryableErrorAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryableErrorAck.DiscardUnknown(m)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RetryableErrorAck proto.InternalMessageInfo
+
+func (m *RetryableErrorAck) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	var l int
-	_ = l
-	l = m.FeePercentage.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return 0
 }
 
-func (m *InFlightPacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OriginalSenderAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.RefundChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.RefundPortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	l = len(m.PacketSrcChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+func (m *RetryableErrorAck) GetContains() string {
+	if m != nil {
+		return m.Contains
 	}
-	l = len(m.PacketSrcPortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+// RetryBackoff defines when timed out forwards are retried. The first retry is
+// scheduled initial_delay after the timeout, and the delay of each further
+// retry is multiplied by multiplier. The timeout of every retry is the timeout
+// of the forward multiplied by multiplier once per retry sent so far.
+type RetryBackoff struct {
+	// initial_delay is the delay before the first retry of a forward. Zero
+	// retries timed out forwards immediately.
+	InitialDelay time.Duration `protobuf:"bytes,1,opt,name=initial_delay,json=initialDelay,proto3,stdduration" json:"initial_delay" yaml:"initial_delay"`
+	// max_delay is the maximum delay before a retry. Zero for no maximum.
+	MaxDelay time.Duration `protobuf:"bytes,2,opt,name=max_delay,json=maxDelay,proto3,stdduration" json:"max_delay" yaml:"max_delay"`
+	// multiplier is the factor the delay and timeout grow by on each retry.
+	// Must be at least one, or zero for delays and timeouts that do not grow.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	// max_timeout is the maximum timeout of a retry. Zero for no maximum.
+	MaxTimeout time.Duration `protobuf:"bytes,4,opt,name=max_timeout,json=maxTimeout,proto3,stdduration" json:"max_timeout" yaml:"max_timeout"`
+	// max_retries_per_block is the maximum number of scheduled retries sent at
+	// the end of a block. Retries beyond it are sent in the following blocks.
+	// Zero for no maximum.
+	MaxRetriesPerBlock uint32 `protobuf:"varint,5,opt,name=max_retries_per_block,json=maxRetriesPerBlock,proto3" json:"max_retries_per_block,omitempty" yaml:"max_retries_per_block"`
+}
+
+func (m *RetryBackoff) Reset()         { *m = RetryBackoff{} }
+func (m *RetryBackoff) String() string { return proto.CompactTextString(m) }
+func (*RetryBackoff) ProtoMessage()    {}
+func (*RetryBackoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{7}
+}
+func (m *RetryBackoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryBackoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryBackoff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PacketTimeoutTimestamp != 0 {
-		n += 1 + sovGenesis(uint64(m.PacketTimeoutTimestamp))
+}
+func (m *RetryBackoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryBackoff.Merge(m, src)
+}
+func (m *RetryBackoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryBackoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryBackoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryBackoff proto.InternalMessageInfo
+
+func (m *RetryBackoff) GetInitialDelay() time.Duration {
+	if m != nil {
+		return m.InitialDelay
 	}
-	l = len(m.PacketTimeoutHeight)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *RetryBackoff) GetMaxDelay() time.Duration {
+	if m != nil {
+		return m.MaxDelay
 	}
-	l = len(m.PacketData)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return 0
+}
+
+func (m *RetryBackoff) GetMaxTimeout() time.Duration {
+	if m != nil {
+		return m.MaxTimeout
 	}
-	if m.RefundSequence != 0 {
-		n += 1 + sovGenesis(uint64(m.RefundSequence))
+	return 0
+}
+
+func (m *RetryBackoff) GetMaxRetriesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxRetriesPerBlock
 	}
-	if m.RetriesRemaining != 0 {
-		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	return 0
+}
+
+// RateLimit defines quotas on the volume of a base denom forwarded through a
+// channel of this chain within a rolling window. Inflow is the volume of
+// forwards received on the channel and outflow is the volume of forwards sent
+// to the next hop over the channel. The window is either a number of blocks or
+// a duration, divided into buckets that each cover a tenth of it, and rolls
+// forward one bucket at a time.
+type RateLimit struct {
+	Port    string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// denom is the base denom of the forwarded token, e.g. uatom for any IBC
+	// voucher of uatom.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_inflow is the maximum volume received on the channel within a window.
+	// Zero for no limit.
+	MaxInflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_inflow,json=maxInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_inflow" yaml:"max_inflow"`
+	// max_outflow is the maximum volume sent over the channel within a window.
+	// Zero for no limit.
+	MaxOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_outflow,json=maxOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_outflow" yaml:"max_outflow"`
+	// window_blocks is the length of the window in blocks. Must be set if and
+	// only if window_duration is not.
+	WindowBlocks uint64 `protobuf:"varint,6,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// window_duration is the length of the window in time. Must be set if and
+	// only if window_blocks is not.
+	WindowDuration time.Duration `protobuf:"bytes,7,opt,name=window_duration,json=windowDuration,proto3,stdduration" json:"window_duration" yaml:"window_duration"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{8}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGenesis(uint64(m.Timeout))
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetPort() string {
+	if m != nil {
+		return m.Port
 	}
-	if m.Nonrefundable {
-		n += 2
+	return ""
+}
+
+func (m *RateLimit) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return n
+	return ""
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+
+func (m *RateLimit) GetWindowDuration() time.Duration {
+	if m != nil {
+		return m.WindowDuration
+	}
+	return 0
+}
+
+// RateLimitFlow is the volume recorded for a rate limit in the buckets of its
+// current window.
+type RateLimitFlow struct {
+	Buckets []RateLimitBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{9}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetBuckets() []RateLimitBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// RateLimitBucket is the volume recorded for a rate limit in one bucket of its
+// window.
+type RateLimitBucket struct {
+	// index is the number of bucket lengths, in blocks or time since the Unix
+	// epoch, at which the bucket starts.
+	Index   int64                                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Inflow  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{10}
+}
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// FlowBlock is the block the flow of a forward was recorded in by the rate
+// limits, from which the bucket of each rate limit it was counted in follows.
+type FlowBlock struct {
+	Height int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *FlowBlock) Reset()         { *m = FlowBlock{} }
+func (m *FlowBlock) String() string { return proto.CompactTextString(m) }
+func (*FlowBlock) ProtoMessage()    {}
+func (*FlowBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{11}
+}
+func (m *FlowBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowBlock.Merge(m, src)
+}
+func (m *FlowBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowBlock proto.InternalMessageInfo
+
+func (m *FlowBlock) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FlowBlock) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// RoutingPolicy defines which forwards are accepted by this chain. Deny lists
+// always apply. An allow list only applies when it is not empty, in which case
+// forwards not matching any of its entries are rejected.
+type RoutingPolicy struct {
+	// allowed_channels are the next hop channels forwards may be sent to.
+	AllowedChannels []PortChannel `protobuf:"bytes,1,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels" yaml:"allowed_channels"`
+	// denied_channels are the next hop channels forwards may not be sent to.
+	DeniedChannels []PortChannel `protobuf:"bytes,2,rep,name=denied_channels,json=deniedChannels,proto3" json:"denied_channels" yaml:"denied_channels"`
+	// allowed_routes are the pairs of receiving and next hop channels forwards
+	// may be sent through.
+	AllowedRoutes []ChannelRoute `protobuf:"bytes,3,rep,name=allowed_routes,json=allowedRoutes,proto3" json:"allowed_routes" yaml:"allowed_routes"`
+	// denied_routes are the pairs of receiving and next hop channels forwards
+	// may not be sent through.
+	DeniedRoutes []ChannelRoute `protobuf:"bytes,4,rep,name=denied_routes,json=deniedRoutes,proto3" json:"denied_routes" yaml:"denied_routes"`
+	// allowed_denoms are the denoms that may be forwarded. Entries match either
+	// the base denom of the token or its denom on this chain, e.g. ibc/{hash}.
+	AllowedDenoms []string `protobuf:"bytes,5,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// denied_denoms are the denoms that may not be forwarded. Entries match
+	// either the base denom of the token or its denom on this chain.
+	DeniedDenoms []string `protobuf:"bytes,6,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty" yaml:"denied_denoms"`
+}
+
+func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
+func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
+func (*RoutingPolicy) ProtoMessage()    {}
+func (*RoutingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{12}
+}
+func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoutingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingPolicy.Merge(m, src)
+}
+func (m *RoutingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoutingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
+
+func (m *RoutingPolicy) GetAllowedChannels() []PortChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *RoutingPolicy) GetDeniedChannels() []PortChannel {
+	if m != nil {
+		return m.DeniedChannels
+	}
+	return nil
+}
+
+func (m *RoutingPolicy) GetAllowedRoutes() []ChannelRoute {
+	if m != nil {
+		return m.AllowedRoutes
+	}
+	return nil
+}
+
+func (m *RoutingPolicy) GetDeniedRoutes() []ChannelRoute {
+	if m != nil {
+		return m.DeniedRoutes
+	}
+	return nil
+}
+
+func (m *RoutingPolicy) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *RoutingPolicy) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
+// PortChannel identifies a channel end on this chain.
+type PortChannel struct {
+	Port    string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *PortChannel) Reset()      { *m = PortChannel{} }
+func (*PortChannel) ProtoMessage() {}
+func (*PortChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{13}
+}
+func (m *PortChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PortChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortChannel.Merge(m, src)
+}
+func (m *PortChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortChannel proto.InternalMessageInfo
+
+func (m *PortChannel) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *PortChannel) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+// ChannelRoute is a pair of the channel a packet is received on and the
+// channel it is forwarded to, both on this chain.
+type ChannelRoute struct {
+	// source is the channel the packet is received on.
+	Source PortChannel `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
+	// destination is the next hop channel the packet is forwarded to.
+	Destination PortChannel `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination"`
+}
+
+func (m *ChannelRoute) Reset()         { *m = ChannelRoute{} }
+func (m *ChannelRoute) String() string { return proto.CompactTextString(m) }
+func (*ChannelRoute) ProtoMessage()    {}
+func (*ChannelRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{14}
+}
+func (m *ChannelRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelRoute.Merge(m, src)
+}
+func (m *ChannelRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelRoute proto.InternalMessageInfo
+
+func (m *ChannelRoute) GetSource() PortChannel {
+	if m != nil {
+		return m.Source
+	}
+	return PortChannel{}
+}
+
+func (m *ChannelRoute) GetDestination() PortChannel {
+	if m != nil {
+		return m.Destination
+	}
+	return PortChannel{}
+}
+
+// FeeRecipient defines the destination of forwarding fees.
+type FeeRecipient struct {
+	// type is the kind of fee destination.
+	Type FeeRecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=router.v1.FeeRecipientType" json:"type,omitempty"`
+	// module_name is the name of the module account receiving fees. Only set
+	// when type is FEE_RECIPIENT_TYPE_MODULE_ACCOUNT.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	// address is the bech32 address receiving fees. Only set when type is
+	// FEE_RECIPIENT_TYPE_ADDRESS.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *FeeRecipient) Reset()         { *m = FeeRecipient{} }
+func (m *FeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipient) ProtoMessage()    {}
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{15}
+}
+func (m *FeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipient.Merge(m, src)
+}
+func (m *FeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipient proto.InternalMessageInfo
+
+func (m *FeeRecipient) GetType() FeeRecipientType {
+	if m != nil {
+		return m.Type
+	}
+	return FeeRecipientTypeCommunityPool
+}
+
+func (m *FeeRecipient) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *FeeRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// FeeScheduleEntry defines the fee charged for forwards to a destination
+// channel and/or of a base denom. When several entries match a forward, an
+// entry matching both the channel and the denom takes precedence over one
+// matching only the channel, which takes precedence over one matching only the
+// denom.
+type FeeScheduleEntry struct {
+	// port is the destination port on this chain. Must be set together with
+	// channel, or left empty to match any destination.
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	// channel is the destination channel on this chain. Must be set together
+	// with port, or left empty to match any destination.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// denom is the base denom of the forwarded token, e.g. uatom for any IBC
+	// voucher of uatom. Empty matches any denom.
+	Denom         string                                 `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	FeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_percentage,json=feePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_percentage" yaml:"fee_percentage"`
+	// min_fee is the minimum fee amount, in units of the forwarded token. Only
+	// allowed when denom is set. Zero for no minimum.
+	MinFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee" yaml:"min_fee"`
+	// max_fee is the maximum fee amount, in units of the forwarded token. Only
+	// allowed when denom is set. Zero for no maximum.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee" yaml:"max_fee"`
+}
+
+func (m *FeeScheduleEntry) Reset()         { *m = FeeScheduleEntry{} }
+func (m *FeeScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeScheduleEntry) ProtoMessage()    {}
+func (*FeeScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{16}
+}
+func (m *FeeScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeScheduleEntry.Merge(m, src)
+}
+func (m *FeeScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeScheduleEntry proto.InternalMessageInfo
+
+func (m *FeeScheduleEntry) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *FeeScheduleEntry) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *FeeScheduleEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// InFlightPacket contains information about original packet for
+// writing the acknowledgement and refunding if necessary.
+type InFlightPacket struct {
+	OriginalSenderAddress  string `protobuf:"bytes,1,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	RefundChannelId        string `protobuf:"bytes,2,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	RefundPortId           string `protobuf:"bytes,3,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
+	PacketSrcChannelId     string `protobuf:"bytes,4,opt,name=packet_src_channel_id,json=packetSrcChannelId,proto3" json:"packet_src_channel_id,omitempty"`
+	PacketSrcPortId        string `protobuf:"bytes,5,opt,name=packet_src_port_id,json=packetSrcPortId,proto3" json:"packet_src_port_id,omitempty"`
+	PacketTimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=packet_timeout_timestamp,json=packetTimeoutTimestamp,proto3" json:"packet_timeout_timestamp,omitempty"`
+	PacketTimeoutHeight    string `protobuf:"bytes,7,opt,name=packet_timeout_height,json=packetTimeoutHeight,proto3" json:"packet_timeout_height,omitempty"`
+	PacketData             []byte `protobuf:"bytes,8,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
+	RefundSequence         uint64 `protobuf:"varint,9,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+	RetriesRemaining       int32  `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	Timeout                uint64 `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Nonrefundable          bool   `protobuf:"varint,12,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
+	// forward_packet_data is the packet data of the packet that was sent to the
+	// next hop, with the token denomination in the form known on this chain.
+	// It is used to refund the forward without the next hop's acknowledgement.
+	ForwardPacketData []byte `protobuf:"bytes,13,opt,name=forward_packet_data,json=forwardPacketData,proto3" json:"forward_packet_data,omitempty"`
+	// split is true if the forward is one of several next hops the received
+	// packet was fanned out to, in which case the acknowledgement of the
+	// received packet is written once all of them resolve.
+	Split bool `protobuf:"varint,14,opt,name=split,proto3" json:"split,omitempty"`
+	// recover_address is the address on this chain credited with the funds of
+	// a failed forward, in which case a successful acknowledgement is written
+	// instead of refunding the received packet.
+	RecoverAddress string `protobuf:"bytes,15,opt,name=recover_address,json=recoverAddress,proto3" json:"recover_address,omitempty"`
+	// timeout_height is the timeout height of the forward requested in the
+	// forward metadata, in the format {revision}-{height}.
+	TimeoutHeight string `protobuf:"bytes,16,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// timeout_height_offset is the number of blocks after the latest height of
+	// the next hop's client that a forward times out at, used when no
+	// timeout_height is requested.
+	TimeoutHeightOffset uint64 `protobuf:"varint,17,opt,name=timeout_height_offset,json=timeoutHeightOffset,proto3" json:"timeout_height_offset,omitempty"`
+	// retry_attempts is the number of times the forward was retried after it
+	// timed out.
+	RetryAttempts uint32 `protobuf:"varint,18,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
+	// retry_time is the time a retry of the timed out forward is scheduled for.
+	// While it is set, the funds of the forward are held in the retry escrow
+	// account.
+	RetryTime *time.Time `protobuf:"bytes,19,opt,name=retry_time,json=retryTime,proto3,stdtime" json:"retry_time,omitempty"`
+	// channel_candidates are the channels to the next hop the forward fails over
+	// between, in order, starting with the channel of the forward metadata.
+	// Empty if the forward has no failover channels.
+	ChannelCandidates []ChannelCandidate `protobuf:"bytes,20,rep,name=channel_candidates,json=channelCandidates,proto3" json:"channel_candidates"`
+	// active_candidate is the index of the channel candidate the forward was
+	// last sent over.
+	ActiveCandidate uint32 `protobuf:"varint,21,opt,name=active_candidate,json=activeCandidate,proto3" json:"active_candidate,omitempty"`
+	// fee is the forwarding fee charged when the packet was first forwarded,
+	// held in the fee escrow account until the forward resolves. It is paid to
+	// the fee recipient once the forward succeeds, and returned along with the
+	// funds of the forward otherwise.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,22,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// forward_timeout_height is the absolute timeout height of the packet that
+	// was sent to the next hop, in the format {revision}-{height}.
+	ForwardTimeoutHeight string `protobuf:"bytes,23,opt,name=forward_timeout_height,json=forwardTimeoutHeight,proto3" json:"forward_timeout_height,omitempty"`
+	// forward_timeout_timestamp is the absolute timeout timestamp in
+	// nanoseconds of the packet that was sent to the next hop.
+	ForwardTimeoutTimestamp uint64 `protobuf:"varint,24,opt,name=forward_timeout_timestamp,json=forwardTimeoutTimestamp,proto3" json:"forward_timeout_timestamp,omitempty"`
+	// inflow_block is the block the inflow of the forward was recorded in by the
+	// rate limits, only set if it was recorded.
+	InflowBlock *FlowBlock `protobuf:"bytes,25,opt,name=inflow_block,json=inflowBlock,proto3" json:"inflow_block,omitempty"`
+	// outflow_block is the block the outflow of the forward was recorded in by
+	// the rate limits, only set if it was recorded.
+	OutflowBlock *FlowBlock `protobuf:"bytes,26,opt,name=outflow_block,json=outflowBlock,proto3" json:"outflow_block,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{17}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundPortId() string {
+	if m != nil {
+		return m.RefundPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketSrcChannelId() string {
+	if m != nil {
+		return m.PacketSrcChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketSrcPortId() string {
+	if m != nil {
+		return m.PacketSrcPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.PacketTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacketTimeoutHeight() string {
+	if m != nil {
+		return m.PacketTimeoutHeight
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketData() []byte {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() int32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetNonrefundable() bool {
+	if m != nil {
+		return m.Nonrefundable
+	}
+	return false
+}
+
+func (m *InFlightPacket) GetForwardPacketData() []byte {
+	if m != nil {
+		return m.ForwardPacketData
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetSplit() bool {
+	if m != nil {
+		return m.Split
+	}
+	return false
+}
+
+func (m *InFlightPacket) GetRecoverAddress() string {
+	if m != nil {
+		return m.RecoverAddress
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeoutHeight() string {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeoutHeightOffset() uint64 {
+	if m != nil {
+		return m.TimeoutHeightOffset
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetryAttempts() uint32 {
+	if m != nil {
+		return m.RetryAttempts
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetryTime() *time.Time {
+	if m != nil {
+		return m.RetryTime
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetChannelCandidates() []ChannelCandidate {
+	if m != nil {
+		return m.ChannelCandidates
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetActiveCandidate() uint32 {
+	if m != nil {
+		return m.ActiveCandidate
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetForwardTimeoutHeight() string {
+	if m != nil {
+		return m.ForwardTimeoutHeight
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetForwardTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.ForwardTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetInflowBlock() *FlowBlock {
+	if m != nil {
+		return m.InflowBlock
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetOutflowBlock() *FlowBlock {
+	if m != nil {
+		return m.OutflowBlock
+	}
+	return nil
+}
+
+// ChannelCandidate is a channel on this chain to the next hop of a forward,
+// with the receiver of the forward when sent over it.
+type ChannelCandidate struct {
+	Port     string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	Channel  string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *ChannelCandidate) Reset()         { *m = ChannelCandidate{} }
+func (m *ChannelCandidate) String() string { return proto.CompactTextString(m) }
+func (*ChannelCandidate) ProtoMessage()    {}
+func (*ChannelCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{18}
+}
+func (m *ChannelCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCandidate.Merge(m, src)
+}
+func (m *ChannelCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCandidate proto.InternalMessageInfo
+
+func (m *ChannelCandidate) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *ChannelCandidate) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelCandidate) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// SplitForward tracks a received packet fanned out to several next hops until
+// all of them resolve.
+type SplitForward struct {
+	AckPolicy SplitAckPolicy `protobuf:"varint,1,opt,name=ack_policy,json=ackPolicy,proto3,enum=router.v1.SplitAckPolicy" json:"ack_policy,omitempty"`
+	// receiver is the receiver of the received packet on this chain, which
+	// forwarded the splits.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// pending is the number of splits not acknowledged or timed out yet.
+	Pending   uint32 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Succeeded uint32 `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	// recovered is the number of failed splits whose funds were credited to
+	// their recover address.
+	Recovered uint32 `protobuf:"varint,7,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	// errors are the errors of the splits that failed.
+	Errors []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	// held are the funds of failed splits held in the split escrow account
+	// while the received packet may still be refunded.
+	Held github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=held,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"held"`
+}
+
+func (m *SplitForward) Reset()         { *m = SplitForward{} }
+func (m *SplitForward) String() string { return proto.CompactTextString(m) }
+func (*SplitForward) ProtoMessage()    {}
+func (*SplitForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4940b763c55c4e0b, []int{19}
+}
+func (m *SplitForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SplitForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SplitForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SplitForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplitForward.Merge(m, src)
+}
+func (m *SplitForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *SplitForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplitForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplitForward proto.InternalMessageInfo
+
+func (m *SplitForward) GetAckPolicy() SplitAckPolicy {
+	if m != nil {
+		return m.AckPolicy
+	}
+	return SplitAckPolicyAllOrNothing
+}
+
+func (m *SplitForward) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *SplitForward) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *SplitForward) GetSucceeded() uint32 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *SplitForward) GetRecovered() uint32 {
+	if m != nil {
+		return m.Recovered
+	}
+	return 0
+}
+
+func (m *SplitForward) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+func (m *SplitForward) GetHeld() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Held
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("router.v1.ForwardLimitBehavior", ForwardLimitBehavior_name, ForwardLimitBehavior_value)
+	proto.RegisterEnum("router.v1.PausedForwardBehavior", PausedForwardBehavior_name, PausedForwardBehavior_value)
+	proto.RegisterEnum("router.v1.FeeRecipientType", FeeRecipientType_name, FeeRecipientType_value)
+	proto.RegisterEnum("router.v1.SplitAckPolicy", SplitAckPolicy_name, SplitAckPolicy_value)
+	proto.RegisterType((*GenesisState)(nil), "router.v1.GenesisState")
+	proto.RegisterMapType((map[string]InFlightPacket)(nil), "router.v1.GenesisState.InFlightPacketsEntry")
+	proto.RegisterMapType((map[string]SplitForward)(nil), "router.v1.GenesisState.SplitForwardsEntry")
+	proto.RegisterType((*ChainRoute)(nil), "router.v1.ChainRoute")
+	proto.RegisterType((*PauseScope)(nil), "router.v1.PauseScope")
+	proto.RegisterType((*Params)(nil), "router.v1.Params")
+	proto.RegisterType((*ForwardDefaults)(nil), "router.v1.ForwardDefaults")
+	proto.RegisterType((*ForwardLimits)(nil), "router.v1.ForwardLimits")
+	proto.RegisterType((*RetryableErrorAck)(nil), "router.v1.RetryableErrorAck")
+	proto.RegisterType((*RetryBackoff)(nil), "router.v1.RetryBackoff")
+	proto.RegisterType((*RateLimit)(nil), "router.v1.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "router.v1.RateLimitFlow")
+	proto.RegisterType((*RateLimitBucket)(nil), "router.v1.RateLimitBucket")
+	proto.RegisterType((*FlowBlock)(nil), "router.v1.FlowBlock")
+	proto.RegisterType((*RoutingPolicy)(nil), "router.v1.RoutingPolicy")
+	proto.RegisterType((*PortChannel)(nil), "router.v1.PortChannel")
+	proto.RegisterType((*ChannelRoute)(nil), "router.v1.ChannelRoute")
+	proto.RegisterType((*FeeRecipient)(nil), "router.v1.FeeRecipient")
+	proto.RegisterType((*FeeScheduleEntry)(nil), "router.v1.FeeScheduleEntry")
+	proto.RegisterType((*InFlightPacket)(nil), "router.v1.InFlightPacket")
+	proto.RegisterType((*ChannelCandidate)(nil), "router.v1.ChannelCandidate")
+	proto.RegisterType((*SplitForward)(nil), "router.v1.SplitForward")
+}
+
+func init() { proto.RegisterFile("router/v1/genesis.proto", fileDescriptor_4940b763c55c4e0b) }
+
+var fileDescriptor_4940b763c55c4e0b = []byte{
+	// 2966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x73, 0x1b, 0x59,
+	0xf1, 0xf7, 0x58, 0xb2, 0x63, 0xb5, 0x25, 0x59, 0x7e, 0xb1, 0xe3, 0xb1, 0x92, 0x58, 0xda, 0xd9,
+	0x7c, 0xbf, 0x6b, 0xb2, 0x44, 0x22, 0xd9, 0x85, 0x84, 0xc0, 0xfe, 0x90, 0x64, 0x39, 0x16, 0xeb,
+	0x58, 0xe2, 0x49, 0x61, 0x2b, 0x81, 0x30, 0x8c, 0x67, 0x9e, 0xec, 0xc1, 0xa3, 0x19, 0x31, 0x33,
+	0x72, 0xec, 0x2d, 0x8e, 0x1c, 0x28, 0x9f, 0xb6, 0x80, 0xc3, 0x5e, 0x5c, 0xb5, 0x55, 0xdc, 0xb8,
+	0x72, 0xa0, 0x38, 0x50, 0x05, 0x27, 0xf6, 0xb8, 0x47, 0x8a, 0x83, 0x97, 0xda, 0xfc, 0x07, 0xbe,
+	0x51, 0xc5, 0x81, 0x7a, 0x3f, 0x46, 0x9a, 0x19, 0xcb, 0xc9, 0x1a, 0xc2, 0xc9, 0x7a, 0xaf, 0xbb,
+	0x3f, 0xaf, 0xa7, 0xbb, 0x5f, 0xbf, 0xee, 0x36, 0x2c, 0xb9, 0xce, 0xc0, 0x27, 0x6e, 0x79, 0xff,
+	0x76, 0x79, 0x87, 0xd8, 0xc4, 0x33, 0xbd, 0x52, 0xdf, 0x75, 0x7c, 0x07, 0xa5, 0x38, 0xa1, 0xb4,
+	0x7f, 0x3b, 0xbf, 0xb0, 0xe3, 0xec, 0x38, 0x6c, 0xb7, 0x4c, 0x7f, 0x71, 0x86, 0xfc, 0x8a, 0xee,
+	0x78, 0x3d, 0xc7, 0x2b, 0x6f, 0x6b, 0x1e, 0x29, 0xef, 0xdf, 0xde, 0x26, 0xbe, 0x76, 0xbb, 0xac,
+	0x3b, 0xa6, 0x1d, 0xd0, 0x77, 0x1c, 0x67, 0xc7, 0x22, 0x65, 0xb6, 0xda, 0x1e, 0x74, 0xcb, 0xc6,
+	0xc0, 0xd5, 0x7c, 0xd3, 0x09, 0xe8, 0x85, 0x38, 0xdd, 0x37, 0x7b, 0xc4, 0xf3, 0xb5, 0x5e, 0x9f,
+	0x33, 0x28, 0xff, 0x4c, 0x42, 0xfa, 0x01, 0xd7, 0xa9, 0xed, 0x6b, 0x3e, 0x41, 0x65, 0x98, 0xee,
+	0x6b, 0xae, 0xd6, 0xf3, 0x64, 0xa9, 0x28, 0xad, 0xce, 0xde, 0x99, 0x2f, 0x0d, 0x75, 0x2c, 0xb5,
+	0x18, 0xa1, 0x9a, 0xfc, 0xec, 0xa4, 0x30, 0x81, 0x05, 0x1b, 0xfa, 0x08, 0xe6, 0x4d, 0x5b, 0xed,
+	0x5a, 0xe6, 0xce, 0xae, 0xaf, 0xf6, 0x35, 0x7d, 0x8f, 0xf8, 0x9e, 0x3c, 0x59, 0x4c, 0xac, 0xce,
+	0xde, 0xf9, 0x7a, 0x48, 0x36, 0x7c, 0x48, 0xa9, 0x61, 0xaf, 0x33, 0xfe, 0x16, 0x67, 0xaf, 0xdb,
+	0xbe, 0x7b, 0x58, 0x2d, 0x52, 0xd8, 0xd3, 0x93, 0x82, 0x7c, 0xa8, 0xf5, 0xac, 0xfb, 0xca, 0x19,
+	0x50, 0x05, 0xcf, 0x99, 0x51, 0x39, 0xf4, 0x16, 0x55, 0x76, 0xe0, 0x11, 0x43, 0x4e, 0xb0, 0x03,
+	0x17, 0x23, 0xca, 0x0e, 0x3c, 0xd2, 0xd6, 0x9d, 0x3e, 0x19, 0x29, 0x4c, 0x59, 0xd1, 0x87, 0x90,
+	0xf5, 0xfa, 0x96, 0xe9, 0xab, 0x5d, 0xc7, 0x7d, 0xa6, 0xb9, 0x86, 0x27, 0x27, 0x99, 0xf0, 0xcd,
+	0xf3, 0xb4, 0x6d, 0x53, 0xee, 0x75, 0xc1, 0xcc, 0x75, 0xe5, 0x88, 0x19, 0x2f, 0x4c, 0x41, 0xef,
+	0x42, 0x5a, 0xdf, 0xd5, 0x4c, 0x5b, 0x65, 0x38, 0x9e, 0x3c, 0x75, 0x46, 0xa7, 0x1a, 0x25, 0x63,
+	0xba, 0x14, 0x08, 0xb3, 0xfa, 0x70, 0xc7, 0x43, 0x6f, 0xc3, 0x95, 0xae, 0xe3, 0xea, 0x44, 0x75,
+	0x49, 0x77, 0x60, 0x1b, 0xc4, 0x18, 0x9a, 0x73, 0xba, 0x98, 0x58, 0x4d, 0xe1, 0x05, 0x46, 0xc5,
+	0x82, 0x28, 0x6c, 0x90, 0x7f, 0x0a, 0x0b, 0xe3, 0xcc, 0x89, 0x72, 0x90, 0xd8, 0x23, 0x87, 0xcc,
+	0x8b, 0x29, 0x4c, 0x7f, 0xa2, 0x32, 0x4c, 0xed, 0x6b, 0xd6, 0x80, 0xc8, 0x93, 0xcc, 0xb3, 0xcb,
+	0x21, 0xc5, 0xa2, 0x08, 0x98, 0xf3, 0xdd, 0x9f, 0xbc, 0x27, 0xe5, 0x1f, 0x03, 0x3a, 0xfb, 0xfd,
+	0x63, 0xc0, 0x6f, 0x45, 0xc1, 0x97, 0x42, 0xe0, 0x61, 0xf9, 0x10, 0xb4, 0xf2, 0x89, 0x04, 0x30,
+	0xb2, 0x08, 0x5a, 0x86, 0x19, 0x6e, 0x3e, 0xd3, 0x10, 0xc0, 0x97, 0xd8, 0xba, 0x61, 0x20, 0x04,
+	0xc9, 0xbe, 0xe3, 0xfa, 0x0c, 0x3b, 0x85, 0xd9, 0x6f, 0x24, 0x03, 0x25, 0xdb, 0x36, 0xb1, 0xe4,
+	0xc4, 0x90, 0x9b, 0x2e, 0x29, 0x45, 0xb3, 0x4c, 0xcd, 0x23, 0xdc, 0xb3, 0x29, 0x1c, 0x2c, 0xd1,
+	0x1b, 0x30, 0xb7, 0x4d, 0xf4, 0xdd, 0xb7, 0xee, 0xa8, 0x7d, 0x97, 0x74, 0xcd, 0x03, 0xe1, 0xa4,
+	0x14, 0xce, 0xf2, 0xed, 0x96, 0xd8, 0x55, 0x5a, 0x00, 0xa3, 0xf8, 0x19, 0x1e, 0x2f, 0x8d, 0x3f,
+	0x7e, 0x32, 0x7a, 0xfc, 0x02, 0x4c, 0x19, 0xc4, 0x76, 0x7a, 0x42, 0x2d, 0xbe, 0x50, 0x9e, 0xa7,
+	0x60, 0x9a, 0xdf, 0x1f, 0x64, 0x43, 0xb6, 0x4b, 0x88, 0xda, 0x27, 0xae, 0x4e, 0x6c, 0x5f, 0xdb,
+	0x21, 0x1c, 0xb8, 0xfa, 0x80, 0x86, 0xc4, 0xdf, 0x4f, 0x0a, 0xff, 0xbf, 0x63, 0xfa, 0xbb, 0x83,
+	0xed, 0x92, 0xee, 0xf4, 0xca, 0xe2, 0xfe, 0xf3, 0x3f, 0xb7, 0x3c, 0x63, 0xaf, 0xec, 0x1f, 0xf6,
+	0x89, 0x57, 0x5a, 0x23, 0xfa, 0xe9, 0x49, 0x61, 0x91, 0x5f, 0x95, 0x28, 0x9a, 0x82, 0x33, 0x5d,
+	0x42, 0x5a, 0xc3, 0x35, 0xfa, 0x21, 0xa4, 0x29, 0x87, 0xa7, 0xef, 0x12, 0x63, 0x60, 0x11, 0x71,
+	0x39, 0xaf, 0x86, 0x3c, 0xb4, 0x4e, 0x48, 0x5b, 0x50, 0x79, 0x7c, 0x5f, 0x15, 0x77, 0xf1, 0xf2,
+	0xe8, 0x80, 0x40, 0x5c, 0xc1, 0xb3, 0xdd, 0x11, 0x3b, 0x7a, 0x02, 0xf4, 0x34, 0xd5, 0x25, 0xba,
+	0xd9, 0x37, 0x89, 0xed, 0xcb, 0x89, 0x33, 0xfe, 0x5f, 0x27, 0x04, 0x07, 0xe4, 0xea, 0x35, 0x81,
+	0xbc, 0x30, 0x42, 0x1e, 0xca, 0x2a, 0x38, 0xdd, 0x0d, 0xf1, 0xa2, 0x1f, 0x43, 0x96, 0xa2, 0x98,
+	0xf6, 0x8e, 0xda, 0x77, 0x2c, 0x53, 0x3f, 0x94, 0x93, 0x0c, 0x5c, 0x0e, 0x81, 0x63, 0xce, 0xd0,
+	0x62, 0xf4, 0xea, 0x75, 0x81, 0x2e, 0x0c, 0x13, 0x95, 0x56, 0x70, 0xc6, 0x0d, 0x73, 0xa3, 0xef,
+	0xc3, 0xac, 0xab, 0xf9, 0x44, 0xb5, 0xcc, 0x9e, 0xe9, 0x07, 0xf7, 0x75, 0x21, 0x0c, 0xae, 0xf9,
+	0x64, 0x93, 0x12, 0xab, 0x79, 0x01, 0x8c, 0x04, 0xf0, 0x48, 0x4c, 0xc1, 0xe0, 0x06, 0x6c, 0x1e,
+	0xfa, 0x39, 0x2c, 0xf1, 0x34, 0x13, 0x64, 0x17, 0x75, 0x9b, 0xec, 0x6a, 0xfb, 0xa6, 0xe3, 0xca,
+	0xd3, 0x45, 0x69, 0x35, 0x7b, 0xa7, 0x18, 0x4f, 0x51, 0x86, 0xb8, 0x19, 0x55, 0xc1, 0x57, 0x55,
+	0x4e, 0x4f, 0x0a, 0x2b, 0xfc, 0x98, 0x73, 0xa0, 0x14, 0xbc, 0xd8, 0x1f, 0x27, 0x4a, 0x9d, 0xe1,
+	0x12, 0xdf, 0x3d, 0x54, 0xb7, 0x35, 0x7d, 0xcf, 0xe9, 0x76, 0xe5, 0x4b, 0x67, 0x9c, 0x81, 0x29,
+	0xbd, 0xca, 0xc9, 0x71, 0x67, 0x44, 0x64, 0x15, 0x9c, 0x76, 0x43, 0xbc, 0xc8, 0x83, 0x05, 0xb6,
+	0xd6, 0xb6, 0x2d, 0xa2, 0x12, 0xd7, 0x75, 0x5c, 0x55, 0xd3, 0xf7, 0x3c, 0x79, 0x86, 0x59, 0xed,
+	0x5a, 0xfc, 0x08, 0xca, 0x56, 0xa7, 0x5c, 0x15, 0x7d, 0xaf, 0xfa, 0xba, 0x38, 0xe7, 0x6a, 0xe8,
+	0x9c, 0x18, 0x8e, 0x82, 0x91, 0x1b, 0x97, 0xf3, 0x90, 0x09, 0xd7, 0x0c, 0xe2, 0x9a, 0xfb, 0x44,
+	0x35, 0x6d, 0x9f, 0xb8, 0x3d, 0x62, 0x98, 0xd4, 0xf2, 0x2e, 0xd1, 0x89, 0xb9, 0x4f, 0x5c, 0x39,
+	0x55, 0x94, 0x56, 0x67, 0xaa, 0x6f, 0x9c, 0x9e, 0x14, 0x5e, 0xe7, 0xd0, 0x2f, 0xe2, 0x56, 0x70,
+	0x9e, 0x93, 0x1b, 0x21, 0x2a, 0x16, 0x44, 0xb4, 0x0d, 0xb9, 0xc0, 0xce, 0x06, 0xe9, 0x6a, 0x03,
+	0xcb, 0xf7, 0x64, 0x60, 0xe6, 0xcb, 0x87, 0x63, 0x99, 0xb3, 0xac, 0x09, 0x8e, 0xea, 0xd5, 0xd3,
+	0x93, 0xc2, 0x92, 0x08, 0xe5, 0x98, 0xb4, 0x82, 0xe7, 0xba, 0x51, 0x6e, 0xf4, 0x04, 0xb2, 0x01,
+	0x97, 0x88, 0xb9, 0xd9, 0x33, 0x01, 0x2d, 0x4e, 0xe0, 0xf1, 0x54, 0x5d, 0x0e, 0xdd, 0xf2, 0x88,
+	0x24, 0xbd, 0xe5, 0x61, 0x4e, 0xf4, 0x5d, 0xc8, 0xf4, 0xb4, 0x03, 0xb5, 0x47, 0x7a, 0x8e, 0xea,
+	0x99, 0x1f, 0x11, 0x39, 0x5d, 0x94, 0x56, 0x93, 0x55, 0x79, 0xe4, 0xde, 0x08, 0x59, 0xc1, 0xb3,
+	0x3d, 0xed, 0xe0, 0x21, 0xe9, 0x39, 0x6d, 0xf3, 0x23, 0x82, 0xea, 0x90, 0xa3, 0xe4, 0xe0, 0x8c,
+	0x5d, 0xa7, 0xef, 0xc9, 0x99, 0xa2, 0xb4, 0x9a, 0x09, 0x7f, 0x61, 0x9c, 0x43, 0xc1, 0xd9, 0x9e,
+	0x76, 0x20, 0x14, 0xde, 0xa0, 0x1b, 0x7f, 0x96, 0x60, 0x2e, 0x66, 0x22, 0x9a, 0x29, 0xa9, 0x67,
+	0x4d, 0xc2, 0x4b, 0x8a, 0x0c, 0x0e, 0x96, 0xe8, 0x1d, 0xb8, 0x44, 0xeb, 0x11, 0x67, 0xe0, 0x0f,
+	0x9f, 0x24, 0x5e, 0xaf, 0x94, 0x82, 0x7a, 0xa5, 0xb4, 0x26, 0xea, 0x99, 0xea, 0x0c, 0x0d, 0xa1,
+	0x4f, 0xbe, 0x28, 0x48, 0x38, 0x90, 0x41, 0x1d, 0x58, 0x14, 0x3f, 0xd5, 0x5d, 0xc2, 0x2a, 0x05,
+	0xa7, 0xdb, 0xf5, 0x08, 0x4f, 0x41, 0xc9, 0x6a, 0xf1, 0xf4, 0xa4, 0x70, 0x8d, 0x2b, 0x3e, 0x96,
+	0x4d, 0xc1, 0x97, 0xc5, 0xfe, 0x06, 0xdb, 0x6e, 0xf2, 0xdd, 0x3f, 0x4d, 0x42, 0x26, 0xe2, 0x03,
+	0x74, 0x17, 0xa8, 0xa9, 0xd4, 0xc8, 0x47, 0x54, 0xaf, 0x8c, 0x92, 0x41, 0x88, 0xa8, 0x60, 0xe8,
+	0x69, 0x07, 0x58, 0x7c, 0xdf, 0x13, 0x98, 0xed, 0x99, 0xb6, 0xfa, 0x95, 0xbf, 0x71, 0x25, 0x9a,
+	0x64, 0x42, 0xb2, 0x0a, 0xfb, 0x72, 0xe8, 0x99, 0x76, 0x47, 0x7c, 0xfc, 0x13, 0xae, 0x54, 0x80,
+	0x9d, 0xb8, 0x28, 0xb6, 0x76, 0x10, 0xc7, 0xd6, 0x0e, 0x02, 0xec, 0xef, 0xc0, 0xcc, 0x30, 0x6b,
+	0x25, 0x59, 0xd6, 0x2a, 0x9c, 0x13, 0xa0, 0x41, 0xe6, 0xc1, 0x43, 0x01, 0xa5, 0x06, 0xf3, 0x67,
+	0x12, 0x00, 0x7d, 0x41, 0x75, 0xc7, 0x20, 0x22, 0x00, 0xd8, 0x6f, 0x94, 0x87, 0x19, 0xdd, 0xb1,
+	0x7d, 0xcd, 0xb4, 0x3d, 0xf1, 0x84, 0x0e, 0xd7, 0xca, 0x5f, 0x13, 0x90, 0x0e, 0x67, 0x2a, 0xf4,
+	0x13, 0xc8, 0x98, 0xb6, 0xe9, 0x9b, 0x9a, 0xa5, 0x1a, 0xc4, 0xd2, 0x0e, 0x65, 0xe9, 0x65, 0x1f,
+	0x5c, 0x8c, 0xe6, 0xb6, 0x88, 0x34, 0xff, 0xe4, 0xb4, 0xd8, 0x5b, 0xa3, 0x5b, 0xa8, 0x03, 0x29,
+	0x6a, 0x14, 0x8e, 0xfe, 0x52, 0x57, 0x05, 0x99, 0x33, 0x37, 0x32, 0x67, 0x08, 0x79, 0xa6, 0xa7,
+	0x1d, 0x70, 0xd4, 0x2d, 0x80, 0xde, 0xc0, 0xf2, 0xcd, 0xbe, 0x65, 0x12, 0x97, 0x57, 0x04, 0xd5,
+	0xd2, 0xc5, 0xde, 0x79, 0x1c, 0x42, 0x88, 0xbb, 0x3d, 0xf9, 0x2a, 0xdd, 0xde, 0x86, 0xc5, 0x50,
+	0x28, 0xd3, 0x8a, 0x42, 0xdd, 0xb6, 0x1c, 0x7d, 0x4f, 0x9e, 0x62, 0x11, 0x1f, 0xba, 0x4f, 0x63,
+	0xd9, 0x14, 0x8c, 0x46, 0xb1, 0xdf, 0x22, 0x6e, 0x95, 0x6d, 0xfe, 0x25, 0x01, 0xa9, 0xe1, 0x33,
+	0xfa, 0x2a, 0x2a, 0x29, 0xb4, 0x0d, 0x54, 0x69, 0xd5, 0xb4, 0xbb, 0x96, 0xf3, 0x8c, 0x59, 0x20,
+	0x55, 0xad, 0x5d, 0xc0, 0xa4, 0x0d, 0xdb, 0x3f, 0x3d, 0x29, 0xcc, 0x8f, 0xbe, 0x84, 0x23, 0x29,
+	0x98, 0xfa, 0xbf, 0xc1, 0x7e, 0x23, 0xc2, 0xcd, 0xec, 0x0c, 0x7c, 0x76, 0xc8, 0x14, 0x3b, 0x64,
+	0xed, 0xc2, 0x87, 0x84, 0xac, 0x2e, 0xa0, 0x78, 0x82, 0x68, 0xf2, 0x05, 0x7a, 0x07, 0x32, 0xcf,
+	0x4c, 0xdb, 0x70, 0x9e, 0x71, 0x0b, 0x7a, 0xf2, 0x74, 0x3c, 0x67, 0x47, 0xc8, 0x0a, 0x4e, 0xf3,
+	0x35, 0x33, 0xad, 0x87, 0xba, 0x30, 0x27, 0xe8, 0x41, 0xdb, 0x27, 0x5f, 0x7a, 0x59, 0x40, 0x28,
+	0x22, 0x20, 0xae, 0x44, 0xf0, 0x03, 0x79, 0x1e, 0x14, 0x59, 0xbe, 0x1b, 0xc8, 0x28, 0x1f, 0x40,
+	0x66, 0xe8, 0xc2, 0x75, 0xaa, 0xf7, 0x7d, 0xb8, 0xb4, 0x3d, 0xe0, 0xad, 0x89, 0x54, 0x4c, 0xc4,
+	0x9e, 0xc8, 0x51, 0xd1, 0xc4, 0x58, 0x44, 0xa7, 0x13, 0x08, 0x28, 0x7f, 0x94, 0x60, 0x2e, 0xc6,
+	0x42, 0x1d, 0x6d, 0xda, 0x06, 0x39, 0x60, 0x71, 0x91, 0xc0, 0x7c, 0x81, 0xd6, 0x61, 0x5a, 0x38,
+	0x79, 0xf2, 0xc2, 0xf7, 0xa6, 0x61, 0xfb, 0x58, 0x48, 0xa3, 0x0d, 0xb8, 0x14, 0x38, 0x32, 0xf1,
+	0x1f, 0x01, 0x05, 0xe2, 0xca, 0x53, 0x48, 0xad, 0x5b, 0xc2, 0xfc, 0xe8, 0x0a, 0x4c, 0xf3, 0xf7,
+	0x44, 0x68, 0x2d, 0x56, 0xe8, 0x1e, 0x24, 0xe9, 0x15, 0x13, 0x39, 0x24, 0x7f, 0xc6, 0x15, 0x9d,
+	0xa0, 0x05, 0xe7, 0x6f, 0xda, 0xc7, 0xd4, 0xe2, 0x4c, 0x42, 0xf9, 0x38, 0x09, 0x99, 0x48, 0x3d,
+	0x4b, 0x8b, 0x12, 0xcd, 0xb2, 0x9c, 0x67, 0xc4, 0x50, 0xc5, 0xa5, 0x08, 0x2c, 0x7e, 0x25, 0x5c,
+	0x47, 0x3a, 0xae, 0x5f, 0xe3, 0xe4, 0x6a, 0x41, 0xf8, 0x57, 0x3c, 0xd9, 0x71, 0x69, 0x05, 0xcf,
+	0x89, 0x2d, 0x21, 0xe0, 0x21, 0x15, 0xe6, 0x0c, 0x62, 0x9b, 0xe1, 0x23, 0x26, 0x5f, 0x78, 0xc4,
+	0x4a, 0x34, 0x84, 0x62, 0xc2, 0x0a, 0xce, 0xf2, 0x9d, 0xe1, 0x01, 0x4f, 0x21, 0x1b, 0xa8, 0x21,
+	0x3a, 0x63, 0xde, 0xad, 0x2f, 0x45, 0x3b, 0x63, 0xca, 0xcc, 0x7b, 0xe3, 0x58, 0x15, 0x1f, 0x15,
+	0x56, 0x70, 0x46, 0x6c, 0x88, 0xb6, 0xf9, 0x09, 0x64, 0x84, 0x0a, 0x02, 0x3d, 0xf9, 0x62, 0xf4,
+	0x58, 0xd1, 0x1b, 0x91, 0x55, 0x70, 0x9a, 0xaf, 0x05, 0xf6, 0xfb, 0x23, 0xd5, 0x59, 0xf2, 0x11,
+	0xfd, 0x62, 0xb8, 0x2c, 0x8b, 0xd2, 0x47, 0xda, 0xad, 0xb1, 0x35, 0xbd, 0xe2, 0xe2, 0x04, 0x01,
+	0xc0, 0x7a, 0xf9, 0xf0, 0x15, 0x8f, 0x90, 0x87, 0x0a, 0x70, 0x71, 0xa5, 0x02, 0xb3, 0x21, 0xd3,
+	0x5f, 0x2c, 0x7f, 0xde, 0x4f, 0x7e, 0xf2, 0x69, 0x61, 0x42, 0xf9, 0x85, 0x04, 0xe9, 0xb0, 0x01,
+	0xd0, 0xdb, 0x30, 0xed, 0x39, 0x03, 0x57, 0x27, 0xe2, 0x11, 0x3d, 0xcf, 0xcf, 0x62, 0x6c, 0xc2,
+	0x79, 0xd1, 0xbb, 0x30, 0x6b, 0x10, 0xcf, 0x37, 0x6d, 0x9e, 0x68, 0x26, 0xbf, 0x82, 0x68, 0x58,
+	0x40, 0xf9, 0x95, 0x04, 0xe9, 0x70, 0x27, 0x88, 0xca, 0x90, 0xa4, 0x57, 0x8c, 0x29, 0x91, 0x8d,
+	0xb7, 0xa3, 0x43, 0xb6, 0xce, 0x61, 0x9f, 0x60, 0xc6, 0xc8, 0xea, 0x30, 0x87, 0x36, 0x9d, 0xaa,
+	0xad, 0x89, 0xfb, 0x95, 0x8a, 0xd4, 0x61, 0x23, 0x22, 0x4d, 0xb3, 0x6c, 0xb5, 0xa5, 0xf5, 0x08,
+	0x1b, 0x08, 0x18, 0x86, 0x4b, 0x3c, 0x2f, 0x18, 0x15, 0x88, 0xa5, 0xf2, 0xaf, 0x49, 0xc8, 0xc5,
+	0x9b, 0xdf, 0x57, 0xf2, 0x48, 0x9d, 0xed, 0xf1, 0x93, 0xff, 0xd3, 0x1e, 0xff, 0x31, 0x5c, 0xa2,
+	0xe5, 0x62, 0x97, 0x10, 0xf1, 0x58, 0xbd, 0x7f, 0xe1, 0xc7, 0x2a, 0x3b, 0xaa, 0x3a, 0xbb, 0x84,
+	0x28, 0x78, 0xba, 0x67, 0xda, 0xeb, 0x84, 0x43, 0xd3, 0xc2, 0x9f, 0x10, 0x79, 0xfa, 0xbf, 0x84,
+	0xd6, 0x0e, 0x02, 0x68, 0xed, 0x60, 0x9d, 0x10, 0xe5, 0xd7, 0x00, 0xd9, 0xe8, 0xe8, 0x09, 0x7d,
+	0x0b, 0x96, 0x1c, 0xd7, 0xdc, 0x31, 0x6d, 0xcd, 0x52, 0x3d, 0x62, 0x1b, 0xc4, 0x55, 0x03, 0xdf,
+	0x71, 0x7f, 0x2c, 0x06, 0xe4, 0x36, 0xa3, 0x56, 0x38, 0x11, 0xdd, 0x84, 0x79, 0x3e, 0x36, 0x0b,
+	0x12, 0x11, 0x1d, 0x23, 0x71, 0x57, 0xcd, 0x71, 0x82, 0x88, 0xcd, 0x86, 0x81, 0x6e, 0x40, 0x56,
+	0xf0, 0x52, 0xdf, 0x52, 0x46, 0xee, 0xbb, 0x34, 0xdf, 0xa5, 0x81, 0xdc, 0x30, 0xd0, 0x6d, 0x58,
+	0xe4, 0xf3, 0x37, 0xd5, 0x73, 0xf5, 0x30, 0x2a, 0xf3, 0x24, 0x46, 0x9c, 0xd8, 0x76, 0xf5, 0x11,
+	0xf0, 0x9b, 0x80, 0x42, 0x22, 0x01, 0xf8, 0x14, 0xd7, 0x62, 0xc8, 0x2f, 0xf0, 0xef, 0x81, 0x2c,
+	0x98, 0x83, 0xf6, 0x64, 0x38, 0x9c, 0xe5, 0x75, 0x00, 0xbe, 0xc2, 0xe9, 0xa2, 0x3e, 0x1b, 0xbe,
+	0x1b, 0xe8, 0xce, 0x50, 0xb3, 0x68, 0x63, 0xc3, 0x5e, 0xff, 0x14, 0xbe, 0x1c, 0x11, 0xe3, 0xcd,
+	0x0d, 0x2a, 0xc0, 0xac, 0x90, 0x31, 0x34, 0x5f, 0x93, 0x67, 0x8a, 0xd2, 0x6a, 0x1a, 0x03, 0xdf,
+	0x5a, 0xd3, 0x7c, 0x8d, 0xce, 0xc6, 0x84, 0x51, 0x3c, 0xf2, 0xb3, 0x01, 0xb1, 0x75, 0xc2, 0xba,
+	0xeb, 0x24, 0x16, 0xb6, 0x6a, 0x8b, 0x5d, 0xf4, 0x26, 0xb5, 0x34, 0xaf, 0xfd, 0x5c, 0xd2, 0xd3,
+	0x4c, 0xdb, 0xb4, 0x77, 0x58, 0xa7, 0x3c, 0x85, 0x73, 0x82, 0x80, 0x83, 0x7d, 0x7a, 0x6f, 0x82,
+	0x5a, 0x75, 0x96, 0xa1, 0x05, 0x4b, 0x74, 0x03, 0x32, 0xb6, 0x63, 0x73, 0x6c, 0xda, 0x2b, 0xb0,
+	0x7e, 0x75, 0x06, 0x47, 0x37, 0x51, 0x09, 0x2e, 0x07, 0x1d, 0x67, 0x58, 0xfd, 0x0c, 0x53, 0x7f,
+	0x5e, 0x90, 0x5a, 0xa3, 0xaf, 0x58, 0x80, 0x29, 0x36, 0x94, 0x95, 0xb3, 0x0c, 0x8d, 0x2f, 0xf8,
+	0xb7, 0xe9, 0xce, 0x7e, 0x28, 0x98, 0xe6, 0x98, 0xa9, 0xb2, 0x62, 0x3b, 0x88, 0xa2, 0xff, 0x83,
+	0x6c, 0xcc, 0xa4, 0x39, 0xc6, 0x97, 0x89, 0x74, 0x8a, 0xd4, 0x01, 0xe3, 0x3b, 0xcf, 0x79, 0xf6,
+	0x8d, 0xe3, 0xfa, 0x4a, 0x0a, 0xcd, 0xe7, 0x2b, 0x9a, 0xef, 0x93, 0x5e, 0xdf, 0xf7, 0x64, 0xc4,
+	0x9a, 0x21, 0x3e, 0xb1, 0xa9, 0x88, 0x4d, 0xf4, 0x1e, 0x00, 0x67, 0x63, 0x35, 0xc4, 0xe5, 0x97,
+	0xd6, 0x10, 0x49, 0x56, 0x3f, 0xa4, 0x98, 0x0c, 0xdd, 0x45, 0x2d, 0x40, 0x41, 0xac, 0xea, 0x9a,
+	0x6d, 0x98, 0x86, 0x46, 0xdf, 0xc4, 0x85, 0x33, 0x33, 0x3f, 0x11, 0xb5, 0xb5, 0x80, 0x47, 0xe4,
+	0xec, 0x79, 0x3d, 0xb6, 0xef, 0xa1, 0xaf, 0x41, 0x4e, 0xd3, 0x7d, 0x3a, 0x56, 0x19, 0x02, 0xca,
+	0x8b, 0x4c, 0xf7, 0x39, 0xbe, 0x3f, 0xe4, 0x45, 0x4f, 0x21, 0x41, 0xf3, 0xc4, 0x15, 0x76, 0xda,
+	0x72, 0x89, 0xa7, 0x83, 0x12, 0xfd, 0xef, 0x45, 0x49, 0xfc, 0xf7, 0xa2, 0x54, 0x73, 0x4c, 0xbb,
+	0xfa, 0x0d, 0x7a, 0xd6, 0xef, 0xbe, 0x28, 0xac, 0x7e, 0x85, 0x14, 0x42, 0x05, 0x3c, 0x4c, 0x71,
+	0xc5, 0x84, 0x9c, 0x45, 0x43, 0xcc, 0x4d, 0x4b, 0x45, 0x49, 0x4c, 0xc8, 0x29, 0x35, 0x1a, 0xfa,
+	0xf7, 0x61, 0x39, 0x2e, 0x35, 0xba, 0x69, 0x32, 0xf3, 0xd8, 0x52, 0x54, 0x70, 0x74, 0xd5, 0xee,
+	0x42, 0x9a, 0x57, 0x91, 0xa2, 0x15, 0x5a, 0x2e, 0x4a, 0xb1, 0x19, 0xe1, 0xb0, 0x20, 0xc4, 0xb3,
+	0x9c, 0x93, 0x2d, 0xd0, 0xb7, 0x21, 0x23, 0xaa, 0x46, 0x21, 0x99, 0x7f, 0x81, 0x64, 0x5a, 0xb0,
+	0xb2, 0x95, 0xf2, 0x23, 0xc8, 0xc5, 0x9d, 0x73, 0xc1, 0x37, 0x29, 0x0f, 0x33, 0xc3, 0x11, 0x19,
+	0x4f, 0x6d, 0xc3, 0xb5, 0xf2, 0xfb, 0x49, 0x48, 0x87, 0x27, 0xf2, 0xe8, 0x1e, 0x80, 0xa6, 0xef,
+	0x05, 0x13, 0x56, 0xfe, 0x1a, 0x2f, 0xc7, 0xc7, 0xf7, 0x15, 0x7d, 0x8f, 0x97, 0xa4, 0x38, 0xa5,
+	0x05, 0x3f, 0x23, 0xc7, 0x4c, 0x46, 0x8f, 0xa1, 0xca, 0xf5, 0x89, 0x6d, 0xd0, 0xdc, 0x90, 0xe0,
+	0x53, 0x1f, 0xb1, 0x44, 0xd7, 0x20, 0xe5, 0x0d, 0x74, 0x9d, 0x10, 0x83, 0xf0, 0x5c, 0x9a, 0xc1,
+	0xa3, 0x0d, 0x4a, 0x15, 0x77, 0x92, 0x18, 0x2c, 0x9f, 0x65, 0xf0, 0x68, 0x83, 0xd6, 0xdc, 0x6c,
+	0x64, 0x18, 0xcc, 0xed, 0xc5, 0x0a, 0xa9, 0x90, 0xdc, 0x25, 0x96, 0x21, 0x4f, 0xbf, 0xfa, 0xc0,
+	0x63, 0xc0, 0x37, 0x3f, 0x95, 0x60, 0x61, 0xdc, 0xe0, 0x03, 0xbd, 0x07, 0xd7, 0xd6, 0x9b, 0xf8,
+	0xc3, 0x0a, 0x5e, 0x53, 0x37, 0x1b, 0x0f, 0x1b, 0x1d, 0xb5, 0x5a, 0xdf, 0xa8, 0xfc, 0xa0, 0xd1,
+	0xc4, 0x6a, 0x6d, 0xb3, 0xf2, 0xb0, 0x95, 0x9b, 0xc8, 0x5f, 0x3f, 0x3a, 0x2e, 0x2e, 0x8f, 0x93,
+	0xad, 0x59, 0x34, 0xc2, 0x2a, 0x70, 0xfd, 0x1c, 0x00, 0x5c, 0xff, 0x5e, 0xbd, 0xd6, 0xc9, 0x49,
+	0xf9, 0x95, 0xa3, 0xe3, 0x62, 0x7e, 0x1c, 0x02, 0x26, 0x3f, 0x25, 0xba, 0x9f, 0x4f, 0xfe, 0xf2,
+	0xb7, 0x2b, 0x13, 0x37, 0xff, 0x20, 0xc1, 0xe2, 0xd8, 0x89, 0x32, 0xda, 0x80, 0xd7, 0x5a, 0x95,
+	0x47, 0xed, 0xfa, 0x9a, 0x1a, 0x9c, 0x34, 0x3c, 0xa3, 0x8e, 0x71, 0x13, 0xab, 0x95, 0xda, 0x07,
+	0xb9, 0x89, 0xfc, 0x6b, 0x47, 0xc7, 0xc5, 0xeb, 0x63, 0x11, 0x86, 0x73, 0x9c, 0x2d, 0xb8, 0x71,
+	0x1e, 0x52, 0xab, 0xd2, 0x6e, 0xab, 0x9d, 0x0d, 0xdc, 0x7c, 0xf4, 0x60, 0x23, 0x27, 0xe5, 0x6f,
+	0x1c, 0x1d, 0x17, 0x8b, 0x63, 0xc1, 0x5a, 0x9a, 0xe7, 0x75, 0x76, 0x5d, 0x67, 0xb0, 0xb3, 0x2b,
+	0x34, 0xff, 0x94, 0x57, 0x61, 0x91, 0x9a, 0x8f, 0x2a, 0xbd, 0x5e, 0xaf, 0xab, 0xb8, 0x5e, 0x6b,
+	0xb4, 0x1a, 0xf5, 0xad, 0x8e, 0xda, 0x79, 0xdc, 0xaa, 0xab, 0xb5, 0xe6, 0xc3, 0x87, 0x8f, 0xb6,
+	0x1a, 0x9d, 0xc7, 0x6a, 0xab, 0xd9, 0xdc, 0x0c, 0x94, 0x8e, 0x0b, 0xd7, 0x9c, 0x5e, 0x6f, 0x60,
+	0x9b, 0xfe, 0x61, 0xcb, 0x71, 0xac, 0x73, 0x90, 0x1e, 0x36, 0xd7, 0x1e, 0x6d, 0xd6, 0xd5, 0x4a,
+	0xad, 0xd6, 0x7c, 0xb4, 0x45, 0xad, 0x3c, 0x16, 0xe9, 0x21, 0xab, 0x22, 0x2b, 0xba, 0xee, 0x0c,
+	0x6c, 0x3a, 0x18, 0xcb, 0x8f, 0x41, 0xaa, 0xac, 0xad, 0xe1, 0x7a, 0xbb, 0x9d, 0x9b, 0xcc, 0x5f,
+	0x3d, 0x3a, 0x2e, 0x2e, 0xc5, 0x21, 0x82, 0xb7, 0xe5, 0x9b, 0xb0, 0x34, 0x46, 0xb8, 0xfa, 0x08,
+	0x6f, 0xe5, 0x12, 0x79, 0xf9, 0xe8, 0xb8, 0xb8, 0x10, 0x97, 0xac, 0x0e, 0x5c, 0x5b, 0x98, 0xe8,
+	0x37, 0x12, 0x64, 0xa3, 0x17, 0x11, 0xd5, 0xa0, 0xd0, 0x6e, 0x6d, 0x36, 0x3a, 0xd4, 0x7b, 0x6a,
+	0xab, 0xb9, 0xd9, 0xa8, 0x3d, 0x56, 0x2b, 0x9b, 0x9b, 0x6a, 0x13, 0xab, 0x5b, 0xcd, 0xce, 0x46,
+	0x63, 0xeb, 0x41, 0x6e, 0x82, 0x87, 0x4e, 0x54, 0xb0, 0x62, 0x59, 0x4d, 0x77, 0xcb, 0xf1, 0x77,
+	0xe9, 0x65, 0xbc, 0x0b, 0xf2, 0x19, 0x90, 0x56, 0x05, 0x77, 0x1a, 0x95, 0xcd, 0x9c, 0x94, 0x5f,
+	0x3e, 0x3a, 0x2e, 0x2e, 0x46, 0xa5, 0x5b, 0x9a, 0x4b, 0x67, 0x66, 0x5c, 0xad, 0xaa, 0xfe, 0xd9,
+	0x97, 0x2b, 0xd2, 0xe7, 0x5f, 0xae, 0x48, 0xff, 0xf8, 0x72, 0x45, 0xfa, 0xf8, 0xf9, 0xca, 0xc4,
+	0xe7, 0xcf, 0x57, 0x26, 0xfe, 0xf6, 0x7c, 0x65, 0xe2, 0x49, 0x23, 0x74, 0xc1, 0x3c, 0xdf, 0xd5,
+	0xec, 0x1d, 0x62, 0x39, 0xfb, 0xe4, 0xd6, 0x3e, 0xb1, 0xfd, 0x81, 0x4b, 0xbc, 0x32, 0x7f, 0xce,
+	0x6f, 0x89, 0xc4, 0x7b, 0xab, 0x67, 0x1a, 0x86, 0x45, 0x9e, 0x69, 0x2e, 0x29, 0xef, 0xdf, 0x2d,
+	0x8b, 0x7f, 0x98, 0xb3, 0x7b, 0xb8, 0x3d, 0xcd, 0x9e, 0xbd, 0xb7, 0xfe, 0x3d, 0x00, 0x8f, 0xc2,
+	0x68, 0x10, 0x47, 0x1f, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForceRefundedPackets) > 0 {
+		for iNdEx := len(m.ForceRefundedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceRefundedPackets[iNdEx])
+			copy(dAtA[i:], m.ForceRefundedPackets[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForceRefundedPackets[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChainRoutes) > 0 {
+		for iNdEx := len(m.ChainRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SplitForwards) > 0 {
+		for k := range m.SplitForwards {
+			v := m.SplitForwards[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Paused) > 0 {
+		for iNdEx := len(m.Paused) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paused[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InFlightPackets) > 0 {
+		for k := range m.InFlightPackets {
+			v := m.InFlightPackets[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bech32Prefixes) > 0 {
+		for iNdEx := len(m.Bech32Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Bech32Prefixes[iNdEx])
+			copy(dAtA[i:], m.Bech32Prefixes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Bech32Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxForwardHops != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxForwardHops))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxMemoSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMemoSize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ForwardLimits != nil {
+		{
+			size, err := m.ForwardLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ForwardDefaults != nil {
+		{
+			size, err := m.ForwardDefaults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DeriveIntermediateReceiver {
+		i--
+		if m.DeriveIntermediateReceiver {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RetryableErrorAcks) > 0 {
+		for iNdEx := len(m.RetryableErrorAcks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryableErrorAcks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.RetryBackoff.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.PausedForwardBehavior != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PausedForwardBehavior))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.RoutingPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FeeRecipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeSchedule) > 0 {
+		for iNdEx := len(m.FeeSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardDefaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardDefaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardDefaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeightOffset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutHeightOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGenesis(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if m.Retries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Behavior != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Behavior))
+		i--
+		dAtA[i] = 0x20
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGenesis(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinTimeout):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGenesis(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryableErrorAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryableErrorAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryableErrorAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contains) > 0 {
+		i -= len(m.Contains)
+		copy(dAtA[i:], m.Contains)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contains)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryBackoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryBackoff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryBackoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetriesPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetriesPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintGenesis(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDelay):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGenesis(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.InitialDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InitialDelay):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGenesis(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGenesis(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x3a
+	if m.WindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxInflow.Size()
+		i -= size
+		if _, err := m.MaxInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintGenesis(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoutingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoutingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeniedRoutes) > 0 {
+		for iNdEx := len(m.DeniedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeniedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedRoutes) > 0 {
+		for iNdEx := len(m.AllowedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for iNdEx := len(m.DeniedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeniedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OutflowBlock != nil {
+		{
+			size, err := m.OutflowBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.InflowBlock != nil {
+		{
+			size, err := m.InflowBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.ForwardTimeoutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.ForwardTimeoutHeight) > 0 {
+		i -= len(m.ForwardTimeoutHeight)
+		copy(dAtA[i:], m.ForwardTimeoutHeight)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardTimeoutHeight)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.ActiveCandidate != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ActiveCandidate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.ChannelCandidates) > 0 {
+		for iNdEx := len(m.ChannelCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.RetryTime != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RetryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RetryTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintGenesis(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.RetryAttempts != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetryAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.TimeoutHeightOffset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutHeightOffset))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.TimeoutHeight) > 0 {
+		i -= len(m.TimeoutHeight)
+		copy(dAtA[i:], m.TimeoutHeight)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TimeoutHeight)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.RecoverAddress) > 0 {
+		i -= len(m.RecoverAddress)
+		copy(dAtA[i:], m.RecoverAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RecoverAddress)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Split {
+		i--
+		if m.Split {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ForwardPacketData) > 0 {
+		i -= len(m.ForwardPacketData)
+		copy(dAtA[i:], m.ForwardPacketData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardPacketData)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Nonrefundable {
+		i--
+		if m.Nonrefundable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RefundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PacketTimeoutHeight) > 0 {
+		i -= len(m.PacketTimeoutHeight)
+		copy(dAtA[i:], m.PacketTimeoutHeight)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PacketSrcPortId) > 0 {
+		i -= len(m.PacketSrcPortId)
+		copy(dAtA[i:], m.PacketSrcPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PacketSrcChannelId) > 0 {
+		i -= len(m.PacketSrcChannelId)
+		copy(dAtA[i:], m.PacketSrcChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SplitForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SplitForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recovered != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Recovered))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Held) > 0 {
+		for iNdEx := len(m.Held) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Held[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Errors[iNdEx])
+			copy(dAtA[i:], m.Errors[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Errors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Succeeded != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Succeeded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pending != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AckPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AckPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InFlightPackets) > 0 {
+		for k, v := range m.InFlightPackets {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Paused) > 0 {
+		for _, e := range m.Paused {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SplitForwards) > 0 {
+		for k, v := range m.SplitForwards {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ChainRoutes) > 0 {
+		for _, e := range m.ChainRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ForceRefundedPackets) > 0 {
+		for _, s := range m.ForceRefundedPackets {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bech32Prefixes) > 0 {
+		for _, s := range m.Bech32Prefixes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PauseScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeSchedule) > 0 {
+		for _, e := range m.FeeSchedule {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeRecipient.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RoutingPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PausedForwardBehavior != 0 {
+		n += 1 + sovGenesis(uint64(m.PausedForwardBehavior))
+	}
+	l = m.RetryBackoff.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RetryableErrorAcks) > 0 {
+		for _, e := range m.RetryableErrorAcks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DeriveIntermediateReceiver {
+		n += 2
+	}
+	if m.ForwardDefaults != nil {
+		l = m.ForwardDefaults.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ForwardLimits != nil {
+		l = m.ForwardLimits.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxMemoSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMemoSize))
+	}
+	if m.MaxForwardHops != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxForwardHops))
+	}
+	return n
+}
+
+func (m *ForwardDefaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		n += 1 + sovGenesis(uint64(m.Retries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TimeoutHeightOffset != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutHeightOffset))
+	}
+	return n
+}
+
+func (m *ForwardLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Behavior != 0 {
+		n += 1 + sovGenesis(uint64(m.Behavior))
+	}
+	return n
+}
+
+func (m *RetryableErrorAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovGenesis(uint64(m.Code))
+	}
+	l = len(m.Contains)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryBackoff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.InitialDelay)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDelay)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxRetriesPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetriesPerBlock))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxInflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FlowBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RoutingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for _, e := range m.DeniedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedRoutes) > 0 {
+		for _, e := range m.AllowedRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedRoutes) > 0 {
+		for _, e := range m.DeniedRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PortChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Source.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovGenesis(uint64(m.Type))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketSrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketSrcPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.PacketTimeoutTimestamp))
+	}
+	l = len(m.PacketTimeoutHeight)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.RefundSequence))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	if m.Nonrefundable {
+		n += 2
+	}
+	l = len(m.ForwardPacketData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Split {
+		n += 2
+	}
+	l = len(m.RecoverAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.TimeoutHeight)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeoutHeightOffset != 0 {
+		n += 2 + sovGenesis(uint64(m.TimeoutHeightOffset))
+	}
+	if m.RetryAttempts != 0 {
+		n += 2 + sovGenesis(uint64(m.RetryAttempts))
+	}
+	if m.RetryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RetryTime)
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ChannelCandidates) > 0 {
+		for _, e := range m.ChannelCandidates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ActiveCandidate != 0 {
+		n += 2 + sovGenesis(uint64(m.ActiveCandidate))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.ForwardTimeoutHeight)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.ForwardTimeoutTimestamp != 0 {
+		n += 2 + sovGenesis(uint64(m.ForwardTimeoutTimestamp))
+	}
+	if m.InflowBlock != nil {
+		l = m.InflowBlock.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.OutflowBlock != nil {
+		l = m.OutflowBlock.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SplitForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.AckPolicy))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovGenesis(uint64(m.Pending))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovGenesis(uint64(m.Succeeded))
+	}
+	if len(m.Errors) > 0 {
+		for _, s := range m.Errors {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Held) > 0 {
+		for _, e := range m.Held {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Recovered != 0 {
+		n += 1 + sovGenesis(uint64(m.Recovered))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InFlightPackets == nil {
+				m.InFlightPackets = make(map[string]InFlightPacket)
+			}
+			var mapkey string
+			mapvalue := &InFlightPacket{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &InFlightPacket{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.InFlightPackets[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paused = append(m.Paused, PauseScope{})
+			if err := m.Paused[len(m.Paused)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SplitForwards == nil {
+				m.SplitForwards = make(map[string]SplitForward)
+			}
+			var mapkey string
+			mapvalue := &SplitForward{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SplitForward{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SplitForwards[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRoutes = append(m.ChainRoutes, ChainRoute{})
+			if err := m.ChainRoutes[len(m.ChainRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRefundedPackets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceRefundedPackets = append(m.ForceRefundedPackets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Prefixes = append(m.Bech32Prefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSchedule = append(m.FeeSchedule, FeeScheduleEntry{})
+			if err := m.FeeSchedule[len(m.FeeSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoutingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedForwardBehavior", wireType)
+			}
+			m.PausedForwardBehavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedForwardBehavior |= PausedForwardBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableErrorAcks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryableErrorAcks = append(m.RetryableErrorAcks, RetryableErrorAck{})
+			if err := m.RetryableErrorAcks[len(m.RetryableErrorAcks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeriveIntermediateReceiver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeriveIntermediateReceiver = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardDefaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForwardDefaults == nil {
+				m.ForwardDefaults = &ForwardDefaults{}
+			}
+			if err := m.ForwardDefaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForwardLimits == nil {
+				m.ForwardLimits = &ForwardLimits{}
+			}
+			if err := m.ForwardLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoSize", wireType)
+			}
+			m.MaxMemoSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardHops", wireType)
+			}
+			m.MaxForwardHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardHops |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardDefaults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
+			}
+			m.TimeoutHeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behavior", wireType)
+			}
+			m.Behavior = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behavior |= ForwardLimitBehavior(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryableErrorAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryableErrorAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryableErrorAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryBackoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryBackoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryBackoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.InitialDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetriesPerBlock", wireType)
+			}
+			m.MaxRetriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetriesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, RateLimitBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoutingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoutingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoutingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, PortChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedChannels = append(m.DeniedChannels, PortChannel{})
+			if err := m.DeniedChannels[len(m.DeniedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRoutes = append(m.AllowedRoutes, ChannelRoute{})
+			if err := m.AllowedRoutes[len(m.AllowedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedRoutes = append(m.DeniedRoutes, ChannelRoute{})
+			if err := m.DeniedRoutes[len(m.DeniedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FeeRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+			}
+			m.PacketTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nonrefundable = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPacketData = append(m.ForwardPacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForwardPacketData == nil {
+				m.ForwardPacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Split = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeightOffset", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TimeoutHeightOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeightOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAttempts", wireType)
+			}
+			m.RetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -657,13 +7936,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RetryTime == nil {
+				m.RetryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RetryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelCandidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -690,161 +7972,156 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InFlightPackets == nil {
-				m.InFlightPackets = make(map[string]InFlightPacket)
+			m.ChannelCandidates = append(m.ChannelCandidates, ChannelCandidate{})
+			if err := m.ChannelCandidates[len(m.ChannelCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &InFlightPacket{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveCandidate", wireType)
+			}
+			m.ActiveCandidate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &InFlightPacket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveCandidate |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTimeoutTimestamp", wireType)
+			}
+			m.ForwardTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.InFlightPackets[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.InflowBlock == nil {
+				m.InflowBlock = &FlowBlock{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InflowBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowBlock", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -854,23 +8131,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OutflowBlock == nil {
+				m.OutflowBlock = &FlowBlock{}
+			}
+			if err := m.OutflowBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -895,7 +8174,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+func (m *ChannelCandidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -918,15 +8197,15 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelCandidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -954,11 +8233,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -986,11 +8265,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1018,13 +8297,63 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPolicy", wireType)
+			}
+			m.AckPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1034,27 +8363,14 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AckPolicy |= SplitAckPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,13 +8398,13 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			m.PacketTimeoutTimestamp = 0
+			m.Pending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1098,16 +8414,16 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				m.Pending |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var stringLen uint64
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1117,29 +8433,16 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Succeeded |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1149,31 +8452,29 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
-			if m.PacketData == nil {
-				m.PacketData = []byte{}
-			}
+			m.Errors = append(m.Errors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
 			}
-			m.RefundSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1183,54 +8484,31 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RefundSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.RetriesRemaining = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RetriesRemaining |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Held = append(m.Held, types.Coin{})
+			if err := m.Held[len(m.Held)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 12:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
 			}
-			var v int
+			m.Recovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1240,12 +8518,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Recovered |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Nonrefundable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
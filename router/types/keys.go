@@ -1,6 +1,13 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the 29-fee name
@@ -16,12 +23,88 @@ const (
 	QuerierRoute = ModuleName
 )
 
+var (
+	// ParamsKey is the store key for the module parameters
+	ParamsKey = []byte{0x00}
+
+	// InFlightPacketKeyPrefix is the store key prefix for in-flight packets
+	InFlightPacketKeyPrefix = []byte{0x01}
+
+	// ForceRefundedPacketKeyPrefix is the store key prefix for forwarded packets that were refunded by the authority
+	// before the next hop acknowledged or timed them out
+	ForceRefundedPacketKeyPrefix = []byte{0x02}
+
+	// RateLimitFlowKeyPrefix is the store key prefix for the flow recorded for rate limits
+	RateLimitFlowKeyPrefix = []byte{0x03}
+
+	// PausedKeyPrefix is the store key prefix for the scopes forwarding is paused for
+	PausedKeyPrefix = []byte{0x04}
+
+	// SplitForwardKeyPrefix is the store key prefix for received packets fanned out to several next hops
+	SplitForwardKeyPrefix = []byte{0x05}
+
+	// RetryQueueKeyPrefix is the store key prefix for the retries of timed out forwards scheduled for a later block
+	RetryQueueKeyPrefix = []byte{0x06}
+
+	// ChainRouteKeyPrefix is the store key prefix for the routes of the chain registry, keyed by chain ID
+	ChainRouteKeyPrefix = []byte{0x07}
+
+	// ChainAliasKeyPrefix is the store key prefix for the chain IDs of the aliases of the chain registry
+	ChainAliasKeyPrefix = []byte{0x08}
+
+	// Bech32PrefixKeyPrefix is the store key prefix for the chain IDs of the bech32 prefixes of the chain registry
+	Bech32PrefixKeyPrefix = []byte{0x09}
+)
+
 type (
 	NonrefundableKey           struct{}
 	DisableDenomCompositionKey struct{}
 	ProcessedKey               struct{}
 )
 
+// RefundPacketKey returns the key, relative to InFlightPacketKeyPrefix, that the in-flight packet for a
+// forwarded packet is stored under.
 func RefundPacketKey(channelID, portID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
 }
+
+// ParseRefundPacketKey parses a key created with RefundPacketKey back into the channel, port and sequence
+// of the forwarded packet.
+func ParseRefundPacketKey(key []byte) (channelID, portID string, sequence uint64, err error) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) != 3 {
+		return "", "", 0, fmt.Errorf("invalid in-flight packet key: %s", key)
+	}
+	sequence, err = strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid sequence in in-flight packet key %s: %w", key, err)
+	}
+	return parts[0], parts[1], sequence, nil
+}
+
+// RateLimitFlowKey returns the key, relative to RateLimitFlowKeyPrefix, that the flow of the rate limit for a
+// channel and base denom is stored under.
+func RateLimitFlowKey(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", portID, channelID, denom))
+}
+
+// PausedKey returns the key, relative to PausedKeyPrefix, that a pause scope is stored under.
+func PausedKey(scope PauseScope) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", scope.Port, scope.Channel, scope.Denom))
+}
+
+// RetryQueueKey returns the key, relative to RetryQueueKeyPrefix, that the retry of a timed out forwarded packet
+// scheduled for retryTime is stored under. Keys are ordered by the time the retry is due.
+func RetryQueueKey(retryTime time.Time, channelID, portID string, sequence uint64) []byte {
+	return append(sdk.FormatTimeBytes(retryTime), RefundPacketKey(channelID, portID, sequence)...)
+}
+
+// ParseRetryQueueKey parses a key created with RetryQueueKey back into the channel, port and sequence of the
+// timed out forwarded packet.
+func ParseRetryQueueKey(key []byte) (channelID, portID string, sequence uint64, err error) {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) <= timeLen {
+		return "", "", 0, fmt.Errorf("invalid retry queue key: %s", key)
+	}
+	return ParseRefundPacketKey(key[timeLen:])
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a basic validation of the forward defaults fields.
+func (d ForwardDefaults) Validate() error {
+	if d.Retries > math.MaxUint8 {
+		return fmt.Errorf("default retries %d exceeds %d", d.Retries, math.MaxUint8)
+	}
+	if d.Timeout <= 0 {
+		return fmt.Errorf("default timeout must be positive, got %s", d.Timeout)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the forward limits fields.
+func (l ForwardLimits) Validate() error {
+	if l.MaxRetries > math.MaxUint8 {
+		return fmt.Errorf("max retries %d exceeds %d", l.MaxRetries, math.MaxUint8)
+	}
+	if l.MinTimeout < 0 || l.MaxTimeout < 0 {
+		return fmt.Errorf("forward limit timeouts cannot be negative")
+	}
+	if l.MaxTimeout > 0 && l.MaxTimeout < l.MinTimeout {
+		return fmt.Errorf("max timeout %s is less than min timeout %s", l.MaxTimeout, l.MinTimeout)
+	}
+	if _, ok := ForwardLimitBehavior_name[int32(l.Behavior)]; !ok {
+		return fmt.Errorf("unknown forward limit behavior %d", l.Behavior)
+	}
+	return nil
+}
+
+// CheckRetries returns an error if retries exceeds the limits.
+func (l ForwardLimits) CheckRetries(retries uint8) error {
+	if uint32(retries) > l.MaxRetries {
+		return errorsmod.Wrapf(ErrForwardLimitExceeded, "retries %d exceeds maximum %d", retries, l.MaxRetries)
+	}
+	return nil
+}
+
+// CheckTimeout returns an error if timeout is outside the limits.
+func (l ForwardLimits) CheckTimeout(timeout time.Duration) error {
+	if timeout < l.MinTimeout {
+		return errorsmod.Wrapf(ErrForwardLimitExceeded, "timeout %s is less than minimum %s", timeout, l.MinTimeout)
+	}
+	if l.MaxTimeout > 0 && timeout > l.MaxTimeout {
+		return errorsmod.Wrapf(ErrForwardLimitExceeded, "timeout %s exceeds maximum %s", timeout, l.MaxTimeout)
+	}
+	return nil
+}
+
+// CheckForward returns an error if the limits reject the retries, failover channels or timeout set in the forward
+// metadata. A forward is retried once per failover channel, so it cannot have more than the maximum retries.
+func (l ForwardLimits) CheckForward(metadata *ForwardMetadata) error {
+	if l.Behavior != ForwardLimitBehaviorReject {
+		return nil
+	}
+	if metadata.Retries != nil {
+		if err := l.CheckRetries(*metadata.Retries); err != nil {
+			return err
+		}
+	}
+	if failovers := len(metadata.Failover); failovers > int(l.MaxRetries) {
+		return errorsmod.Wrapf(ErrForwardLimitExceeded, "%d failover channels exceed maximum retries %d", failovers, l.MaxRetries)
+	}
+	if timeout := time.Duration(metadata.Timeout); timeout > 0 {
+		if err := l.CheckTimeout(timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClampRetries returns retries capped at the maximum retries.
+func (l ForwardLimits) ClampRetries(retries uint8) uint8 {
+	if uint32(retries) > l.MaxRetries {
+		return uint8(l.MaxRetries)
+	}
+	return retries
+}
+
+// ClampTimeout returns timeout clamped to the minimum and maximum timeout.
+func (l ForwardLimits) ClampTimeout(timeout time.Duration) time.Duration {
+	if timeout < l.MinTimeout {
+		return l.MinTimeout
+	}
+	if l.MaxTimeout > 0 && timeout > l.MaxTimeout {
+		return l.MaxTimeout
+	}
+	return timeout
+}
+
+// validateForwardLimits validates the forward defaults and limits, and that the defaults are within the limits.
+func validateForwardLimits(defaults *ForwardDefaults, limits *ForwardLimits) error {
+	if defaults != nil {
+		if err := defaults.Validate(); err != nil {
+			return err
+		}
+	}
+	if limits != nil {
+		if err := limits.Validate(); err != nil {
+			return err
+		}
+	}
+	if defaults == nil || limits == nil {
+		return nil
+	}
+	if defaults.Retries > limits.MaxRetries {
+		return fmt.Errorf("default retries %d exceeds max retries %d", defaults.Retries, limits.MaxRetries)
+	}
+	if err := limits.CheckTimeout(defaults.Timeout); err != nil {
+		return fmt.Errorf("default timeout outside forward limits: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardLimits(t *testing.T) {
+	limits := types.ForwardLimits{
+		MaxRetries: 3,
+		MinTimeout: time.Minute,
+		MaxTimeout: time.Hour,
+	}
+
+	require.Equal(t, uint8(2), limits.ClampRetries(2))
+	require.Equal(t, uint8(3), limits.ClampRetries(255))
+	require.Equal(t, time.Minute, limits.ClampTimeout(time.Second))
+	require.Equal(t, 10*time.Minute, limits.ClampTimeout(10*time.Minute))
+	require.Equal(t, time.Hour, limits.ClampTimeout(365*24*time.Hour))
+
+	retries := uint8(255)
+	metadata := &types.ForwardMetadata{Retries: &retries, Timeout: types.Duration(365 * 24 * time.Hour)}
+
+	// clamped limits never reject a forward.
+	require.NoError(t, limits.CheckForward(metadata))
+
+	limits.Behavior = types.ForwardLimitBehaviorReject
+	require.ErrorIs(t, limits.CheckForward(metadata), types.ErrForwardLimitExceeded)
+
+	metadata.Retries = nil
+	require.ErrorIs(t, limits.CheckForward(metadata), types.ErrForwardLimitExceeded)
+
+	// unset values are defaulted and clamped instead.
+	metadata.Timeout = 0
+	require.NoError(t, limits.CheckForward(metadata))
+
+	// a forward is retried once per failover channel.
+	metadata.Failover = []*types.FailoverChannel{{Channel: "channel-1"}, {Channel: "channel-2"}, {Channel: "channel-3"}}
+	require.NoError(t, limits.CheckForward(metadata))
+	metadata.Failover = append(metadata.Failover, &types.FailoverChannel{Channel: "channel-4"})
+	require.ErrorIs(t, limits.CheckForward(metadata), types.ErrForwardLimitExceeded)
+	metadata.Failover = nil
+
+	// without a maximum timeout, only the minimum is enforced.
+	limits.MaxTimeout = 0
+	require.NoError(t, limits.CheckTimeout(365*24*time.Hour))
+	require.ErrorIs(t, limits.CheckTimeout(time.Second), types.ErrForwardLimitExceeded)
+}
+
+func TestParamsValidateForwardLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults *types.ForwardDefaults
+		limits   *types.ForwardLimits
+		expErr   bool
+	}{
+		{"unset", nil, nil, false},
+		{"defaults", &types.ForwardDefaults{Retries: 2, Timeout: time.Hour, TimeoutHeightOffset: 100}, nil, false},
+		{"limits", nil, &types.ForwardLimits{MaxRetries: 5, MinTimeout: time.Minute, MaxTimeout: time.Hour}, false},
+		{"defaults within limits", &types.ForwardDefaults{Retries: 2, Timeout: time.Hour}, &types.ForwardLimits{MaxRetries: 2, MaxTimeout: time.Hour}, false},
+		{"default retries overflow", &types.ForwardDefaults{Retries: 256, Timeout: time.Hour}, nil, true},
+		{"zero default timeout", &types.ForwardDefaults{Retries: 2}, nil, true},
+		{"max retries overflow", nil, &types.ForwardLimits{MaxRetries: 256}, true},
+		{"negative min timeout", nil, &types.ForwardLimits{MinTimeout: -time.Minute}, true},
+		{"max timeout below min timeout", nil, &types.ForwardLimits{MinTimeout: time.Hour, MaxTimeout: time.Minute}, true},
+		{"unknown behavior", nil, &types.ForwardLimits{Behavior: 2}, true},
+		{"default retries above max retries", &types.ForwardDefaults{Retries: 3, Timeout: time.Hour}, &types.ForwardLimits{MaxRetries: 2}, true},
+		{"default timeout above max timeout", &types.ForwardDefaults{Timeout: 2 * time.Hour}, &types.ForwardLimits{MaxTimeout: time.Hour}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ForwardDefaults = tc.defaults
+			params.ForwardLimits = tc.limits
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgForceRefund{}
+	_ sdk.Msg = &MsgSetPaused{}
+	_ sdk.Msg = &MsgSetChainRoute{}
+	_ sdk.Msg = &MsgRemoveChainRoute{}
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// NewMsgForceRefund creates a new MsgForceRefund instance
+func NewMsgForceRefund(authority, channelID, portID string, sequence uint64) *MsgForceRefund {
+	return &MsgForceRefund{
+		Authority: authority,
+		ChannelId: channelID,
+		PortId:    portID,
+		Sequence:  sequence,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgForceRefund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgForceRefund message.
+func (m *MsgForceRefund) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgForceRefund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return err
+	}
+	if m.TimeoutHeight != "" {
+		if _, err := clienttypes.ParseHeight(m.TimeoutHeight); err != nil {
+			return errorsmod.Wrap(err, "invalid timeout height")
+		}
+	}
+	return nil
+}
+
+// NewMsgSetPaused creates a new MsgSetPaused instance
+func NewMsgSetPaused(authority string, scope PauseScope, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Authority: authority,
+		Scope:     scope,
+		Paused:    paused,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetPaused message.
+func (m *MsgSetPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Scope.Validate()
+}
+
+// NewMsgSetChainRoute creates a new MsgSetChainRoute instance
+func NewMsgSetChainRoute(authority string, route ChainRoute) *MsgSetChainRoute {
+	return &MsgSetChainRoute{
+		Authority: authority,
+		Route:     route,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetChainRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetChainRoute message.
+func (m *MsgSetChainRoute) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetChainRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Route.Validate()
+}
+
+// NewMsgRemoveChainRoute creates a new MsgRemoveChainRoute instance
+func NewMsgRemoveChainRoute(authority, chainID string) *MsgRemoveChainRoute {
+	return &MsgRemoveChainRoute{
+		Authority: authority,
+		ChainId:   chainID,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveChainRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveChainRoute message.
+func (m *MsgRemoveChainRoute) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveChainRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return validateChainName(m.ChainId)
+}
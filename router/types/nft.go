@@ -0,0 +1,106 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NonFungibleTokenPacketData is the ICS-721 packet data of an NFT transfer. It is defined here so that the
+// middleware can forward NFT packets without depending on a specific ICS-721 implementation.
+type NonFungibleTokenPacketData struct {
+	ClassID   string   `json:"classId"`
+	ClassURI  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIDs  []string `json:"tokenIds"`
+	TokenURIs []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// NewNonFungibleTokenPacketData returns the packet data of an NFT transfer.
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassID:   classID,
+		ClassURI:  classURI,
+		ClassData: classData,
+		TokenIDs:  tokenIDs,
+		TokenURIs: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ParseNonFungibleTokenPacketData decodes ICS-721 packet data. Unknown fields are rejected so that ICS-20 packet
+// data is never mistaken for an NFT transfer.
+func ParseNonFungibleTokenPacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&data); err != nil {
+		return NonFungibleTokenPacketData{}, err
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return NonFungibleTokenPacketData{}, err
+	}
+	return data, nil
+}
+
+// ValidateBasic performs a basic check of the packet fields.
+func (d NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(d.ClassID) == "" {
+		return errors.New("class id cannot be blank")
+	}
+	if len(d.TokenIDs) == 0 {
+		return errors.New("token ids cannot be empty")
+	}
+	if strings.TrimSpace(d.Receiver) == "" {
+		return errors.New("receiver address cannot be blank")
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data.
+func (d NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// GetDenom returns the class ID of the NFTs.
+func (d NonFungibleTokenPacketData) GetDenom() string {
+	return d.ClassID
+}
+
+// GetAmount returns the token IDs separated by commas, used as the amount of NFT forwards in events.
+func (d NonFungibleTokenPacketData) GetAmount() string {
+	return strings.Join(d.TokenIDs, ",")
+}
+
+// GetSender returns the sender of the NFTs.
+func (d NonFungibleTokenPacketData) GetSender() string {
+	return d.Sender
+}
+
+// GetReceiver returns the receiver of the NFTs.
+func (d NonFungibleTokenPacketData) GetReceiver() string {
+	return d.Receiver
+}
+
+// GetMemo returns the memo of the transfer.
+func (d NonFungibleTokenPacketData) GetMemo() string {
+	return d.Memo
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNonFungibleTokenPacketData(t *testing.T) {
+	const (
+		sender   = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		receiver = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+
+	nftData := types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "2"}, nil, nil, sender, receiver, "memo")
+	ftData := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, receiver, "memo")
+
+	tests := []struct {
+		name   string
+		data   []byte
+		expErr bool
+	}{
+		{"nft packet", nftData.GetBytes(), false},
+		{"fungible token packet", ftData.GetBytes(), true},
+		{"missing token ids", types.NewNonFungibleTokenPacketData("class", "", "", nil, nil, nil, sender, receiver, "").GetBytes(), true},
+		{"missing class id", types.NewNonFungibleTokenPacketData("", "", "", []string{"1"}, nil, nil, sender, receiver, "").GetBytes(), true},
+		{"invalid json", []byte("{"), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := types.ParseNonFungibleTokenPacketData(tc.data)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, nftData, data)
+			require.Equal(t, "1,2", data.GetAmount())
+		})
+	}
+}
+
+func TestParseForwardPacketData(t *testing.T) {
+	const (
+		sender   = "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"
+		receiver = "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k"
+	)
+
+	nftData := types.NewNonFungibleTokenPacketData("class", "", "", []string{"1", "2"}, nil, nil, sender, receiver, "memo")
+	ftData := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, receiver, "memo")
+
+	tests := []struct {
+		name   string
+		data   []byte
+		expErr bool
+		exp    types.ForwardPacketData
+	}{
+		{"fungible token packet", ftData.GetBytes(), false, &ftData},
+		{"nft packet", nftData.GetBytes(), false, &nftData},
+		{"invalid nft packet", types.NewNonFungibleTokenPacketData("", "", "", []string{"1"}, nil, nil, sender, receiver, "").GetBytes(), true, nil},
+		{"invalid json", []byte("{"), true, nil},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := types.ParseForwardPacketData(tc.data)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, data)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+var (
+	_ ForwardPacketData = &transfertypes.FungibleTokenPacketData{}
+	_ ForwardPacketData = &NonFungibleTokenPacketData{}
+)
+
+// ForwardPacketData is the packet data of a transfer the middleware forwards: ICS-20 fungible token packet data, or
+// ICS-721 NFT packet data. The denom of NFT packet data is its class ID, and its amount the token IDs separated by
+// commas, as reported in events.
+type ForwardPacketData interface {
+	GetDenom() string
+	GetAmount() string
+	GetSender() string
+	GetReceiver() string
+	GetMemo() string
+	GetBytes() []byte
+}
+
+// ParseForwardPacketData decodes the data of an ICS-20 transfer packet, or of an ICS-721 transfer packet if it is not
+// one. The error decoding it as ICS-20 packet data is returned if it is neither.
+func ParseForwardPacketData(bz []byte) (ForwardPacketData, error) {
+	var data transfertypes.FungibleTokenPacketData
+	err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &data)
+	if err == nil {
+		return &data, nil
+	}
+	if nftData, nftErr := ParseNonFungibleTokenPacketData(bz); nftErr == nil {
+		return &nftData, nil
+	}
+	return nil, err
+}
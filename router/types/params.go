@@ -4,16 +4,27 @@ import (
 	fmt "fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 var (
 	DefaultFeePercentage = sdk.NewDec(0)
+	// DefaultMaxMemoSize is the default maximum size in bytes of the memo of received packets to forward
+	DefaultMaxMemoSize = uint64(32768)
+	// DefaultMaxForwardHops is the default maximum number of hops of the route of received packets to forward
+	DefaultMaxForwardHops = uint32(10)
+	// DefaultMaxRetriesPerBlock is the default maximum number of scheduled retries sent at the end of a block
+	DefaultMaxRetriesPerBlock = uint32(100)
 	// KeyFeePercentage is store's key for FeePercentage Params
 	KeyFeePercentage = []byte("FeePercentage")
 )
 
 // ParamKeyTable type declaration for parameters
+//
+// Deprecated: parameters are stored in the module store. The key table is only used to migrate
+// parameters out of the legacy x/params subspace.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -21,7 +32,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new parameter configuration for the ibc transfer module
 func NewParams(feePercentage sdk.Dec) Params {
 	return Params{
-		FeePercentage: feePercentage,
+		FeePercentage:  feePercentage,
+		RetryBackoff:   RetryBackoff{Multiplier: sdk.OneDec(), MaxRetriesPerBlock: DefaultMaxRetriesPerBlock},
+		MaxMemoSize:    DefaultMaxMemoSize,
+		MaxForwardHops: DefaultMaxForwardHops,
 	}
 }
 
@@ -32,13 +46,44 @@ func DefaultParams() Params {
 
 // Validate all ibc-transfer module parameters
 func (p Params) Validate() error {
-	return validateFeePercentage(p.FeePercentage)
+	if err := validateFeePercentage(p.FeePercentage); err != nil {
+		return err
+	}
+	if err := validateFeeSchedule(p.FeeSchedule); err != nil {
+		return err
+	}
+	if err := p.FeeRecipient.Validate(); err != nil {
+		return err
+	}
+	if err := p.RoutingPolicy.Validate(); err != nil {
+		return err
+	}
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return err
+	}
+	if _, ok := PausedForwardBehavior_name[int32(p.PausedForwardBehavior)]; !ok {
+		return fmt.Errorf("unknown paused forward behavior %d", p.PausedForwardBehavior)
+	}
+	if err := p.RetryBackoff.Validate(); err != nil {
+		return err
+	}
+	for i, retryable := range p.RetryableErrorAcks {
+		if err := retryable.Validate(); err != nil {
+			return fmt.Errorf("invalid retryable error ack %d: %w", i, err)
+		}
+	}
+	if err := validateForwardLimits(p.ForwardDefaults, p.ForwardLimits); err != nil {
+		return err
+	}
+	return nil
 }
 
 // ParamSetPairs implements params.ParamSet
+//
+// Deprecated: only used to migrate parameters out of the legacy x/params subspace.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyFeePercentage, p.FeePercentage, validateFeePercentage),
+		paramtypes.NewParamSetPair(KeyFeePercentage, &p.FeePercentage, validateFeePercentage),
 	}
 }
 
@@ -56,3 +101,166 @@ func validateFeePercentage(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeSchedule(schedule []FeeScheduleEntry) error {
+	seen := make(map[string]struct{}, len(schedule))
+	for i, entry := range schedule {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("invalid fee schedule entry %d: %w", i, err)
+		}
+		key := entry.Port + "/" + entry.Channel + "/" + entry.Denom
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate fee schedule entry for port (%s) channel (%s) denom (%s)", entry.Port, entry.Channel, entry.Denom)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the fee schedule entry fields.
+func (e FeeScheduleEntry) Validate() error {
+	if e.Port == "" && e.Channel == "" && e.Denom == "" {
+		return fmt.Errorf("at least one of port and channel, or denom must be set")
+	}
+	if e.Port != "" || e.Channel != "" {
+		if err := host.PortIdentifierValidator(e.Port); err != nil {
+			return fmt.Errorf("invalid port: %w", err)
+		}
+		if err := host.ChannelIdentifierValidator(e.Channel); err != nil {
+			return fmt.Errorf("invalid channel: %w", err)
+		}
+	}
+	if e.Denom != "" {
+		if err := sdk.ValidateDenom(e.Denom); err != nil {
+			return err
+		}
+	}
+	if e.FeePercentage.IsNil() {
+		return fmt.Errorf("fee percentage must be set")
+	}
+	if err := validateFeePercentage(e.FeePercentage); err != nil {
+		return err
+	}
+
+	minFee, maxFee := e.GetMinFee(), e.GetMaxFee()
+	if minFee.IsNegative() || maxFee.IsNegative() {
+		return fmt.Errorf("min and max fee cannot be negative")
+	}
+	if (minFee.IsPositive() || maxFee.IsPositive()) && e.Denom == "" {
+		return fmt.Errorf("min and max fee require a denom")
+	}
+	if minFee.IsPositive() && maxFee.IsPositive() && minFee.GT(maxFee) {
+		return fmt.Errorf("min fee %s is greater than max fee %s", minFee, maxFee)
+	}
+
+	return nil
+}
+
+// GetMinFee returns the minimum fee amount of the entry, zero if unset.
+func (e FeeScheduleEntry) GetMinFee() sdk.Int {
+	if e.MinFee.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return e.MinFee
+}
+
+// GetMaxFee returns the maximum fee amount of the entry, zero if unset.
+func (e FeeScheduleEntry) GetMaxFee() sdk.Int {
+	if e.MaxFee.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return e.MaxFee
+}
+
+// Matches returns whether the entry applies to a forward to the destination port and channel of a token
+// with the given base denom.
+func (e FeeScheduleEntry) Matches(port, channel, baseDenom string) bool {
+	if e.Channel != "" && (e.Port != port || e.Channel != channel) {
+		return false
+	}
+	return e.Denom == "" || e.Denom == baseDenom
+}
+
+// Fee computes the fee for forwarding amount according to the entry.
+func (e FeeScheduleEntry) Fee(amount sdk.Int) sdk.Int {
+	fee := sdk.NewDecFromInt(amount).Mul(e.FeePercentage).RoundInt()
+	if minFee := e.GetMinFee(); fee.LT(minFee) {
+		fee = minFee
+	}
+	if maxFee := e.GetMaxFee(); maxFee.IsPositive() && fee.GT(maxFee) {
+		fee = maxFee
+	}
+	return fee
+}
+
+// specificity ranks how specific the entry is, higher values taking precedence over lower ones.
+func (e FeeScheduleEntry) specificity() int {
+	switch {
+	case e.Channel != "" && e.Denom != "":
+		return 3
+	case e.Channel != "":
+		return 2
+	default:
+		return 1
+	}
+}
+
+// FeeScheduleEntry returns the most specific fee schedule entry matching a forward to the destination
+// port and channel of a token with the given base denom.
+func (p Params) FeeScheduleEntry(port, channel, baseDenom string) (FeeScheduleEntry, bool) {
+	var (
+		best  FeeScheduleEntry
+		found bool
+	)
+	for _, entry := range p.FeeSchedule {
+		if !entry.Matches(port, channel, baseDenom) {
+			continue
+		}
+		if !found || entry.specificity() > best.specificity() {
+			best, found = entry, true
+		}
+	}
+	return best, found
+}
+
+// HasDenomFeeSchedule returns whether any fee schedule entry is keyed by denom.
+func (p Params) HasDenomFeeSchedule() bool {
+	for _, entry := range p.FeeSchedule {
+		if entry.Denom != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs a basic validation of the fee recipient fields.
+func (r FeeRecipient) Validate() error {
+	switch r.Type {
+	case FeeRecipientTypeCommunityPool, FeeRecipientTypeBurn:
+		if r.ModuleName != "" || r.Address != "" {
+			return fmt.Errorf("fee recipient %s does not take a module name or address", r.Type)
+		}
+	case FeeRecipientTypeModuleAccount:
+		if r.ModuleName == "" {
+			return fmt.Errorf("fee recipient module name cannot be empty")
+		}
+		if r.Address != "" {
+			return fmt.Errorf("fee recipient %s does not take an address", r.Type)
+		}
+	case FeeRecipientTypeAddress:
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid fee recipient address: %w", err)
+		}
+		if r.ModuleName != "" {
+			return fmt.Errorf("fee recipient %s does not take a module name", r.Type)
+		}
+	default:
+		return fmt.Errorf("unknown fee recipient type %d", r.Type)
+	}
+	return nil
+}
+
+// FeeEscrowAddress returns the address holding the forwarding fees of in-flight forwards until they resolve.
+func FeeEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("fee"))
+}
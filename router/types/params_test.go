@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateFeeSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []types.FeeScheduleEntry
+		expErr  bool
+	}{
+		{"empty schedule", nil, false},
+		{"channel entry", []types.FeeScheduleEntry{{Port: "transfer", Channel: "channel-0", FeePercentage: sdk.NewDecWithPrec(1, 2)}}, false},
+		{"denom entry with min and max", []types.FeeScheduleEntry{{Denom: "uatom", FeePercentage: sdk.NewDecWithPrec(1, 2), MinFee: sdk.NewInt(1), MaxFee: sdk.NewInt(100)}}, false},
+		{"no key", []types.FeeScheduleEntry{{FeePercentage: sdk.NewDecWithPrec(1, 2)}}, true},
+		{"channel without port", []types.FeeScheduleEntry{{Channel: "channel-0", FeePercentage: sdk.NewDecWithPrec(1, 2)}}, true},
+		{"missing fee percentage", []types.FeeScheduleEntry{{Denom: "uatom"}}, true},
+		{"fee percentage above one", []types.FeeScheduleEntry{{Denom: "uatom", FeePercentage: sdk.NewDec(2)}}, true},
+		{"min fee without denom", []types.FeeScheduleEntry{{Port: "transfer", Channel: "channel-0", FeePercentage: sdk.ZeroDec(), MinFee: sdk.NewInt(1)}}, true},
+		{"min fee above max fee", []types.FeeScheduleEntry{{Denom: "uatom", FeePercentage: sdk.ZeroDec(), MinFee: sdk.NewInt(10), MaxFee: sdk.NewInt(1)}}, true},
+		{"duplicate entries", []types.FeeScheduleEntry{
+			{Denom: "uatom", FeePercentage: sdk.ZeroDec()},
+			{Denom: "uatom", FeePercentage: sdk.NewDecWithPrec(1, 2)},
+		}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.FeeSchedule = tc.entries
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsFeeScheduleEntry(t *testing.T) {
+	channelEntry := types.FeeScheduleEntry{Port: "transfer", Channel: "channel-0", FeePercentage: sdk.NewDecWithPrec(2, 2)}
+	denomEntry := types.FeeScheduleEntry{Denom: "uatom", FeePercentage: sdk.NewDecWithPrec(3, 2)}
+	bothEntry := types.FeeScheduleEntry{Port: "transfer", Channel: "channel-0", Denom: "uatom", FeePercentage: sdk.NewDecWithPrec(4, 2)}
+
+	params := types.DefaultParams()
+	params.FeeSchedule = []types.FeeScheduleEntry{denomEntry, bothEntry, channelEntry}
+
+	entry, found := params.FeeScheduleEntry("transfer", "channel-0", "uatom")
+	require.True(t, found)
+	require.Equal(t, bothEntry, entry)
+
+	entry, found = params.FeeScheduleEntry("transfer", "channel-0", "uosmo")
+	require.True(t, found)
+	require.Equal(t, channelEntry, entry)
+
+	entry, found = params.FeeScheduleEntry("transfer", "channel-1", "uatom")
+	require.True(t, found)
+	require.Equal(t, denomEntry, entry)
+
+	_, found = params.FeeScheduleEntry("transfer", "channel-1", "uosmo")
+	require.False(t, found)
+}
+
+func TestFeeScheduleEntryFee(t *testing.T) {
+	entry := types.FeeScheduleEntry{
+		Denom:         "uatom",
+		FeePercentage: sdk.NewDecWithPrec(10, 2),
+		MinFee:        sdk.NewInt(5),
+		MaxFee:        sdk.NewInt(50),
+	}
+
+	require.Equal(t, sdk.NewInt(5), entry.Fee(sdk.NewInt(10)))
+	require.Equal(t, sdk.NewInt(20), entry.Fee(sdk.NewInt(200)))
+	require.Equal(t, sdk.NewInt(50), entry.Fee(sdk.NewInt(1000)))
+}
+
+func TestFeeRecipientValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		recipient types.FeeRecipient
+		expErr    bool
+	}{
+		{"community pool", types.FeeRecipient{}, false},
+		{"burn", types.FeeRecipient{Type: types.FeeRecipientTypeBurn}, false},
+		{"module account", types.FeeRecipient{Type: types.FeeRecipientTypeModuleAccount, ModuleName: "relayer_incentives"}, false},
+		{"address", types.FeeRecipient{Type: types.FeeRecipientTypeAddress, Address: "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"}, false},
+		{"module account without name", types.FeeRecipient{Type: types.FeeRecipientTypeModuleAccount}, true},
+		{"invalid address", types.FeeRecipient{Type: types.FeeRecipientTypeAddress, Address: "invalid"}, true},
+		{"community pool with address", types.FeeRecipient{Address: "cosmos1vzxkv3lxccnttr9rs0002s93sgw72h7ghukuhs"}, true},
+		{"unknown type", types.FeeRecipient{Type: 100}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recipient.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPauseScope returns a PauseScope for the given port, channel and denom. All empty is the global scope.
+func NewPauseScope(port, channel, denom string) PauseScope {
+	return PauseScope{Port: port, Channel: channel, Denom: denom}
+}
+
+// Validate checks that the scope is either global, a port and channel, or a denom.
+func (s PauseScope) Validate() error {
+	if s.Port != "" || s.Channel != "" {
+		if s.Denom != "" {
+			return fmt.Errorf("pause scope cannot have both a channel and a denom")
+		}
+		return NewPortChannel(s.Port, s.Channel).Validate()
+	}
+	if s.Denom != "" {
+		return sdk.ValidateDenom(s.Denom)
+	}
+	return nil
+}
+
+// Description returns a human readable description of the scope for error messages.
+func (s PauseScope) Description() string {
+	switch {
+	case s.Channel != "":
+		return fmt.Sprintf("channel %s", NewPortChannel(s.Port, s.Channel))
+	case s.Denom != "":
+		return fmt.Sprintf("denom %s", s.Denom)
+	default:
+		return "all forwards"
+	}
+}
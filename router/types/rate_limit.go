@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimitWindowBuckets is the number of buckets the window of a rate limit is divided into. The window rolls
+// forward one bucket at a time, so volume recorded in a bucket stops counting towards the quota once the whole
+// window has passed since the bucket started.
+const RateLimitWindowBuckets = 10
+
+func validateRateLimits(rateLimits []RateLimit) error {
+	seen := make(map[string]struct{}, len(rateLimits))
+	for i, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit %d: %w", i, err)
+		}
+		key := string(RateLimitFlowKey(rateLimit.Port, rateLimit.Channel, rateLimit.Denom))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate rate limit for port (%s) channel (%s) denom (%s)", rateLimit.Port, rateLimit.Channel, rateLimit.Denom)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the rate limit fields.
+func (r RateLimit) Validate() error {
+	if err := NewPortChannel(r.Port, r.Channel).Validate(); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	maxInflow, maxOutflow := r.GetMaxInflow(), r.GetMaxOutflow()
+	if maxInflow.IsNegative() || maxOutflow.IsNegative() {
+		return fmt.Errorf("max inflow and outflow cannot be negative")
+	}
+	if maxInflow.IsZero() && maxOutflow.IsZero() {
+		return fmt.Errorf("at least one of max inflow and max outflow must be set")
+	}
+
+	if r.WindowDuration < 0 {
+		return fmt.Errorf("window duration cannot be negative")
+	}
+	if (r.WindowBlocks == 0) == (r.WindowDuration == 0) {
+		return fmt.Errorf("exactly one of window blocks and window duration must be set")
+	}
+
+	return nil
+}
+
+// GetMaxInflow returns the maximum inflow of the rate limit, zero if unset.
+func (r RateLimit) GetMaxInflow() sdk.Int {
+	if r.MaxInflow.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return r.MaxInflow
+}
+
+// GetMaxOutflow returns the maximum outflow of the rate limit, zero if unset.
+func (r RateLimit) GetMaxOutflow() sdk.Int {
+	if r.MaxOutflow.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return r.MaxOutflow
+}
+
+// Bucket returns the index of the bucket of the window of the rate limit that a block at the given height and time
+// falls in.
+func (r RateLimit) Bucket(height int64, blockTime time.Time) int64 {
+	if r.WindowBlocks > 0 {
+		return height / r.bucketLength()
+	}
+	return blockTime.UnixNano() / r.bucketLength()
+}
+
+// InWindow returns whether a bucket is within the window ending with the current bucket.
+func (r RateLimit) InWindow(bucket, current int64) bool {
+	windowLength := int64(r.WindowBlocks)
+	if r.WindowBlocks == 0 {
+		windowLength = r.WindowDuration.Nanoseconds()
+	}
+	bucketLength := r.bucketLength()
+	windowBuckets := (windowLength + bucketLength - 1) / bucketLength
+	return bucket <= current && bucket > current-windowBuckets
+}
+
+// bucketLength returns the length of a bucket of the window, in blocks or nanoseconds.
+func (r RateLimit) bucketLength() int64 {
+	length := int64(r.WindowBlocks)
+	if r.WindowBlocks == 0 {
+		length = r.WindowDuration.Nanoseconds()
+	}
+	if length < RateLimitWindowBuckets {
+		return 1
+	}
+	return length / RateLimitWindowBuckets
+}
+
+// RateLimit returns the rate limit for a channel and base denom.
+func (p Params) RateLimit(port, channel, baseDenom string) (RateLimit, bool) {
+	for _, rateLimit := range p.RateLimits {
+		if rateLimit.Port == port && rateLimit.Channel == channel && rateLimit.Denom == baseDenom {
+			return rateLimit, true
+		}
+	}
+	return RateLimit{}, false
+}
+
+// Inflow returns the volume received on the channel of the rate limit within the window.
+func (f RateLimitFlow) Inflow() sdk.Int {
+	inflow := sdk.ZeroInt()
+	for _, bucket := range f.Buckets {
+		inflow = inflow.Add(bucket.Inflow)
+	}
+	return inflow
+}
+
+// Outflow returns the volume sent over the channel of the rate limit within the window.
+func (f RateLimitFlow) Outflow() sdk.Int {
+	outflow := sdk.ZeroInt()
+	for _, bucket := range f.Buckets {
+		outflow = outflow.Add(bucket.Outflow)
+	}
+	return outflow
+}
+
+// Prune removes the buckets outside the window of the rate limit ending with the current bucket.
+func (f *RateLimitFlow) Prune(rateLimit RateLimit, current int64) {
+	buckets := f.Buckets[:0]
+	for _, bucket := range f.Buckets {
+		if rateLimit.InWindow(bucket.Index, current) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	f.Buckets = buckets
+}
+
+// Add records inflow and outflow in a bucket, which is created if no volume was recorded in it yet.
+func (f *RateLimitFlow) Add(index int64, inflow, outflow sdk.Int) {
+	for i := range f.Buckets {
+		if f.Buckets[i].Index == index {
+			f.Buckets[i].Inflow = f.Buckets[i].Inflow.Add(inflow)
+			f.Buckets[i].Outflow = f.Buckets[i].Outflow.Add(outflow)
+			return
+		}
+	}
+	f.Buckets = append(f.Buckets, RateLimitBucket{Index: index, Inflow: inflow, Outflow: outflow})
+}
+
+// Release releases inflow and outflow recorded in a bucket, never reducing its volume below zero. Nothing is
+// released if the flow does not have the bucket, i.e. the window the volume was recorded in has rolled past it.
+func (f *RateLimitFlow) Release(index int64, inflow, outflow sdk.Int) {
+	for i := range f.Buckets {
+		if f.Buckets[i].Index == index {
+			f.Buckets[i].Inflow = sdk.MaxInt(f.Buckets[i].Inflow.Sub(inflow), sdk.ZeroInt())
+			f.Buckets[i].Outflow = sdk.MaxInt(f.Buckets[i].Outflow.Sub(outflow), sdk.ZeroInt())
+			return
+		}
+	}
+}
+
+// NewFlowBlock returns the flow block of the block in ctx.
+func NewFlowBlock(ctx sdk.Context) *FlowBlock {
+	return &FlowBlock{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	}
+}
@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	valid := types.RateLimit{
+		Port:         "transfer",
+		Channel:      "channel-0",
+		Denom:        "uatom",
+		MaxOutflow:   sdk.NewInt(100),
+		WindowBlocks: 10,
+	}
+
+	tests := []struct {
+		name     string
+		malleate func(r *types.RateLimit)
+		expErr   bool
+	}{
+		{"valid block window", func(r *types.RateLimit) {}, false},
+		{"valid time window", func(r *types.RateLimit) { r.WindowBlocks, r.WindowDuration = 0, time.Hour }, false},
+		{"invalid channel", func(r *types.RateLimit) { r.Channel = "" }, true},
+		{"missing denom", func(r *types.RateLimit) { r.Denom = "" }, true},
+		{"no quota", func(r *types.RateLimit) { r.MaxOutflow = sdk.ZeroInt() }, true},
+		{"negative quota", func(r *types.RateLimit) { r.MaxInflow = sdk.NewInt(-1) }, true},
+		{"no window", func(r *types.RateLimit) { r.WindowBlocks = 0 }, true},
+		{"both windows", func(r *types.RateLimit) { r.WindowDuration = time.Hour }, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit := valid
+			tc.malleate(&rateLimit)
+			err := rateLimit.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitWindow(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// windows of blocks are divided into buckets of a tenth of the window.
+	blocks := types.RateLimit{WindowBlocks: 100}
+	require.Equal(t, int64(1), blocks.Bucket(10, start))
+	require.Equal(t, int64(1), blocks.Bucket(19, start))
+	require.Equal(t, int64(2), blocks.Bucket(20, start))
+	require.True(t, blocks.InWindow(1, 10))
+	require.False(t, blocks.InWindow(1, 11))
+	require.False(t, blocks.InWindow(2, 1))
+
+	// windows shorter than the number of buckets have buckets of a single block.
+	short := types.RateLimit{WindowBlocks: 5}
+	require.Equal(t, int64(14), short.Bucket(14, start))
+	require.True(t, short.InWindow(10, 14))
+	require.False(t, short.InWindow(10, 15))
+
+	duration := types.RateLimit{WindowDuration: time.Minute}
+	bucket := duration.Bucket(0, start)
+	require.Equal(t, bucket, duration.Bucket(0, start.Add(5*time.Second)))
+	require.Equal(t, bucket+1, duration.Bucket(0, start.Add(6*time.Second)))
+	require.True(t, duration.InWindow(bucket, duration.Bucket(0, start.Add(59*time.Second))))
+	require.False(t, duration.InWindow(bucket, duration.Bucket(0, start.Add(time.Minute))))
+}
+
+func TestRateLimitFlow(t *testing.T) {
+	rateLimit := types.RateLimit{WindowBlocks: 100}
+	var flow types.RateLimitFlow
+
+	flow.Add(1, sdk.NewInt(100), sdk.ZeroInt())
+	flow.Add(5, sdk.NewInt(50), sdk.NewInt(10))
+	flow.Add(5, sdk.NewInt(50), sdk.ZeroInt())
+	require.Equal(t, sdk.NewInt(200), flow.Inflow())
+	require.Equal(t, sdk.NewInt(10), flow.Outflow())
+
+	// the volume recorded in a bucket stops counting once the window rolls past it, instead of the whole flow
+	// resetting at once.
+	flow.Prune(rateLimit, 11)
+	require.Equal(t, sdk.NewInt(100), flow.Inflow())
+	require.Equal(t, sdk.NewInt(10), flow.Outflow())
+
+	// quota is only released in the bucket it was recorded in, and never below zero.
+	flow.Release(1, sdk.NewInt(100), sdk.ZeroInt())
+	require.Equal(t, sdk.NewInt(100), flow.Inflow())
+	flow.Release(5, sdk.NewInt(60), sdk.NewInt(20))
+	require.Equal(t, sdk.NewInt(40), flow.Inflow())
+	require.True(t, flow.Outflow().IsZero())
+}
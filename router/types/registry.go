@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// maxChainNameLength is the maximum length of a chain ID or alias, the maximum chain ID length of CometBFT.
+const maxChainNameLength = 50
+
+// Validate performs a basic validation of the route and checks that it does not name the same chain or bech32
+// prefix twice.
+func (r ChainRoute) Validate() error {
+	if err := validateChainName(r.ChainId); err != nil {
+		return fmt.Errorf("invalid chain ID: %w", err)
+	}
+	if err := NewPortChannel(r.Port, r.Channel).Validate(); err != nil {
+		return fmt.Errorf("invalid channel of chain %s: %w", r.ChainId, err)
+	}
+
+	names := map[string]bool{r.ChainId: true}
+	for _, alias := range r.Aliases {
+		if err := validateChainName(alias); err != nil {
+			return fmt.Errorf("invalid alias of chain %s: %w", r.ChainId, err)
+		}
+		if names[alias] {
+			return fmt.Errorf("chain %s is named %s more than once", r.ChainId, alias)
+		}
+		names[alias] = true
+	}
+
+	prefixes := make(map[string]bool)
+	for _, prefix := range r.Bech32Prefixes {
+		if prefix == "" || prefix != strings.ToLower(prefix) || strings.IndexFunc(prefix, unicode.IsSpace) >= 0 || strings.Contains(prefix, "/") {
+			return fmt.Errorf("invalid bech32 prefix %q of chain %s", prefix, r.ChainId)
+		}
+		if prefixes[prefix] {
+			return fmt.Errorf("chain %s has bech32 prefix %s more than once", r.ChainId, prefix)
+		}
+		prefixes[prefix] = true
+	}
+	return nil
+}
+
+// Names returns the chain ID and aliases of the route.
+func (r ChainRoute) Names() []string {
+	return append([]string{r.ChainId}, r.Aliases...)
+}
+
+// ValidateChainRoutes validates each route and checks that no chain ID, alias or bech32 prefix belongs to more than
+// one of them.
+func ValidateChainRoutes(routes []ChainRoute) error {
+	chains := make(map[string]string)
+	prefixes := make(map[string]string)
+	for _, route := range routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		for _, name := range route.Names() {
+			if chainID, found := chains[name]; found {
+				return fmt.Errorf("chain name %s belongs to both chain %s and chain %s", name, chainID, route.ChainId)
+			}
+			chains[name] = route.ChainId
+		}
+		for _, prefix := range route.Bech32Prefixes {
+			if chainID, found := prefixes[prefix]; found {
+				return fmt.Errorf("bech32 prefix %s belongs to both chain %s and chain %s", prefix, chainID, route.ChainId)
+			}
+			prefixes[prefix] = route.ChainId
+		}
+	}
+	return nil
+}
+
+// validateChainName checks that a chain ID or alias is not empty, not too long and does not contain whitespace or
+// path separators.
+func validateChainName(name string) error {
+	if name == "" || len(name) > maxChainNameLength {
+		return fmt.Errorf("chain name %q must be between 1 and %d characters", name, maxChainNameLength)
+	}
+	if strings.IndexFunc(name, unicode.IsSpace) >= 0 || strings.Contains(name, "/") {
+		return fmt.Errorf("chain name %q cannot contain whitespace or slashes", name)
+	}
+	return nil
+}
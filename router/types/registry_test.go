@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateChainRoutes(t *testing.T) {
+	osmosis := types.ChainRoute{
+		ChainId: "osmosis-1", Port: "transfer", Channel: "channel-141",
+		Aliases: []string{"osmosis"}, Bech32Prefixes: []string{"osmo"},
+	}
+
+	tests := []struct {
+		name     string
+		routes   []types.ChainRoute
+		expValid bool
+	}{
+		{"no routes", nil, true},
+		{"valid route", []types.ChainRoute{osmosis}, true},
+		{"empty chain ID", []types.ChainRoute{{Port: "transfer", Channel: "channel-0"}}, false},
+		{"chain ID with whitespace", []types.ChainRoute{{ChainId: "osmosis 1", Port: "transfer", Channel: "channel-0"}}, false},
+		{"invalid channel", []types.ChainRoute{{ChainId: "osmosis-1", Port: "transfer", Channel: "141"}}, false},
+		{"alias same as chain ID", []types.ChainRoute{{ChainId: "osmosis-1", Port: "transfer", Channel: "channel-0", Aliases: []string{"osmosis-1"}}}, false},
+		{"uppercase bech32 prefix", []types.ChainRoute{{ChainId: "osmosis-1", Port: "transfer", Channel: "channel-0", Bech32Prefixes: []string{"OSMO"}}}, false},
+		{"duplicate chain ID", []types.ChainRoute{osmosis, {ChainId: "osmosis-1", Port: "transfer", Channel: "channel-0"}}, false},
+		{"alias of another chain", []types.ChainRoute{osmosis, {ChainId: "osmo-test", Port: "transfer", Channel: "channel-0", Aliases: []string{"osmosis"}}}, false},
+		{"bech32 prefix of another chain", []types.ChainRoute{osmosis, {ChainId: "osmo-test", Port: "transfer", Channel: "channel-0", Bech32Prefixes: []string{"osmo"}}}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateChainRoutes(tc.routes)
+			if tc.expValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
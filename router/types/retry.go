@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Validate performs a basic validation of the retry backoff fields.
+func (b RetryBackoff) Validate() error {
+	if b.InitialDelay < 0 || b.MaxDelay < 0 || b.MaxTimeout < 0 {
+		return fmt.Errorf("retry backoff durations cannot be negative")
+	}
+	if b.MaxDelay > 0 && b.MaxDelay < b.InitialDelay {
+		return fmt.Errorf("retry backoff max delay %s is less than initial delay %s", b.MaxDelay, b.InitialDelay)
+	}
+	if !b.Multiplier.IsNil() && !b.Multiplier.IsZero() && b.Multiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("retry backoff multiplier must be at least one, got %s", b.Multiplier)
+	}
+	return nil
+}
+
+// GetMultiplier returns the multiplier of the backoff, one if unset.
+func (b RetryBackoff) GetMultiplier() sdk.Dec {
+	if b.Multiplier.IsNil() || b.Multiplier.IsZero() {
+		return sdk.OneDec()
+	}
+	return b.Multiplier
+}
+
+// Delay returns the delay before the given retry of a timed out forward, starting at one for the first retry.
+// Zero means the retry is sent immediately.
+func (b RetryBackoff) Delay(attempt uint32) time.Duration {
+	if b.InitialDelay == 0 || attempt == 0 {
+		return 0
+	}
+	return b.scale(b.InitialDelay, attempt-1, b.MaxDelay)
+}
+
+// Timeout returns the timeout of the given retry of a forward with the given timeout, starting at one for the
+// first retry.
+func (b RetryBackoff) Timeout(timeout time.Duration, attempt uint32) time.Duration {
+	return b.scale(timeout, attempt, b.MaxTimeout)
+}
+
+// scale multiplies d by the multiplier n times, capped at max if set.
+func (b RetryBackoff) scale(d time.Duration, n uint32, max time.Duration) time.Duration {
+	if max <= 0 {
+		max = math.MaxInt64
+	}
+	multiplier := b.GetMultiplier()
+	scaled := sdk.NewDec(int64(d))
+	for i := uint32(0); i < n && multiplier.GT(sdk.OneDec()); i++ {
+		scaled = scaled.Mul(multiplier)
+		if scaled.GTE(sdk.NewDec(int64(max))) {
+			return max
+		}
+	}
+	if scaled.GTE(sdk.NewDec(int64(max))) {
+		return max
+	}
+	return time.Duration(scaled.TruncateInt64())
+}
+
+// RetryEscrowAddress returns the address holding the funds of timed out forwards until their scheduled retry.
+func RetryEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("retry"))
+}
+
+// Validate performs a basic validation of the retryable error acknowledgement fields. Note that ABCI codes are only
+// unique within the codespace of a module, which ibc-go redacts from acknowledgements along with the error message,
+// so a code matches the errors of every module registering it, e.g. code 5 is both ErrInsufficientFunds of the SDK
+// and ErrRateLimitExceeded of this module. Only add codes that are retryable for every application on the next hop.
+func (e RetryableErrorAck) Validate() error {
+	if e.Code == 0 && e.Contains == "" {
+		return fmt.Errorf("at least one of code or contains must be set")
+	}
+	return nil
+}
+
+// Matches returns whether the error of an acknowledgement belongs to the retryable class. Since ibc-go v7 redacts
+// the error of acknowledgements written with channeltypes.NewErrorAcknowledgement to "ABCI code: {code}: error
+// handling packet: see events for details", Contains only matches acknowledgements of counterparties that write
+// the error in full, such as chains running ibc-go before v7.
+func (e RetryableErrorAck) Matches(ackErr string) bool {
+	if e.Code != 0 && !strings.HasPrefix(ackErr, fmt.Sprintf("ABCI code: %d:", e.Code)) {
+		return false
+	}
+	return strings.Contains(ackErr, e.Contains)
+}
+
+// IsRetryableErrorAck returns whether the error of an acknowledgement matches any of the retryable error
+// acknowledgements.
+func (p Params) IsRetryableErrorAck(ackErr string) bool {
+	for _, retryable := range p.RetryableErrorAcks {
+		if retryable.Matches(ackErr) {
+			return true
+		}
+	}
+	return false
+}
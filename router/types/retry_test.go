@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	backoff := types.RetryBackoff{
+		InitialDelay: time.Minute,
+		MaxDelay:     5 * time.Minute,
+		Multiplier:   sdk.NewDec(2),
+		MaxTimeout:   time.Hour,
+	}
+
+	require.Equal(t, time.Minute, backoff.Delay(1))
+	require.Equal(t, 2*time.Minute, backoff.Delay(2))
+	require.Equal(t, 4*time.Minute, backoff.Delay(3))
+	require.Equal(t, 5*time.Minute, backoff.Delay(4))
+	require.Equal(t, 5*time.Minute, backoff.Delay(255))
+
+	require.Equal(t, 20*time.Minute, backoff.Timeout(10*time.Minute, 1))
+	require.Equal(t, 40*time.Minute, backoff.Timeout(10*time.Minute, 2))
+	require.Equal(t, time.Hour, backoff.Timeout(10*time.Minute, 3))
+
+	// the defaults retry immediately with the timeout of the forward.
+	backoff = types.DefaultParams().RetryBackoff
+	require.Equal(t, time.Duration(0), backoff.Delay(1))
+	require.Equal(t, 10*time.Minute, backoff.Timeout(10*time.Minute, 3))
+
+	// without a maximum, the timeout stops growing before it overflows.
+	backoff = types.RetryBackoff{Multiplier: sdk.NewDec(10)}
+	require.Equal(t, time.Duration(1<<63-1), backoff.Timeout(time.Hour, 255))
+}
+
+func TestRetryBackoffValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff types.RetryBackoff
+		expErr  bool
+	}{
+		{"empty", types.RetryBackoff{}, false},
+		{"growing delay", types.RetryBackoff{InitialDelay: time.Minute, MaxDelay: time.Hour, Multiplier: sdk.NewDecWithPrec(15, 1)}, false},
+		{"negative delay", types.RetryBackoff{InitialDelay: -time.Minute}, true},
+		{"max delay below initial delay", types.RetryBackoff{InitialDelay: time.Hour, MaxDelay: time.Minute}, true},
+		{"multiplier below one", types.RetryBackoff{Multiplier: sdk.NewDecWithPrec(5, 1)}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.backoff.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRetryableErrorAck(t *testing.T) {
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrRateLimitExceeded)
+	rateLimited := ack.GetError()
+	// ibc-go redacts the codespace along with the error, so codes of different modules cannot be told apart.
+	insufficientFundsAck := channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds)
+	insufficientFunds := insufficientFundsAck.GetError()
+	require.Equal(t, "ABCI code: 5: error handling packet: see events for details", rateLimited)
+
+	tests := []struct {
+		name      string
+		retryable types.RetryableErrorAck
+		ackErr    string
+		expMatch  bool
+	}{
+		{"code", types.RetryableErrorAck{Code: 5}, rateLimited, true},
+		{"other code", types.RetryableErrorAck{Code: 4}, rateLimited, false},
+		{"code prefix of another code", types.RetryableErrorAck{Code: 5}, "ABCI code: 55: error handling packet", false},
+		{"contains", types.RetryableErrorAck{Contains: "insufficient liquidity"}, "swap failed: insufficient liquidity", true},
+		{"code and contains", types.RetryableErrorAck{Code: 5, Contains: "liquidity"}, rateLimited, false},
+		{"contains redacted error", types.RetryableErrorAck{Contains: types.ErrRateLimitExceeded.Error()}, rateLimited, false},
+		{"code of another module", types.RetryableErrorAck{Code: 5}, insufficientFunds, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.retryable.Validate())
+			require.Equal(t, tc.expMatch, tc.retryable.Matches(tc.ackErr))
+		})
+	}
+
+	require.Error(t, types.RetryableErrorAck{}.Validate())
+}
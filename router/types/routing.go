@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewPortChannel returns a PortChannel for the given port and channel identifiers.
+func NewPortChannel(port, channel string) PortChannel {
+	return PortChannel{Port: port, Channel: channel}
+}
+
+// Validate performs a basic validation of the port and channel identifiers.
+func (pc PortChannel) Validate() error {
+	if err := host.PortIdentifierValidator(pc.Port); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(pc.Channel)
+}
+
+// String returns the port and channel identifiers separated by a slash.
+func (pc PortChannel) String() string {
+	return pc.Port + "/" + pc.Channel
+}
+
+// Validate performs a basic validation of the routing policy entries.
+func (p RoutingPolicy) Validate() error {
+	for _, channels := range [][]PortChannel{p.AllowedChannels, p.DeniedChannels} {
+		for _, pc := range channels {
+			if err := pc.Validate(); err != nil {
+				return fmt.Errorf("invalid routing policy channel %s: %w", pc, err)
+			}
+		}
+	}
+	for _, routes := range [][]ChannelRoute{p.AllowedRoutes, p.DeniedRoutes} {
+		for _, route := range routes {
+			if err := route.Source.Validate(); err != nil {
+				return fmt.Errorf("invalid routing policy route source %s: %w", route.Source, err)
+			}
+			if err := route.Destination.Validate(); err != nil {
+				return fmt.Errorf("invalid routing policy route destination %s: %w", route.Destination, err)
+			}
+		}
+	}
+	for _, denoms := range [][]string{p.AllowedDenoms, p.DeniedDenoms} {
+		for _, denom := range denoms {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid routing policy denom: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// Check returns an error if a forward of a token, received on source and sent to destination, is not allowed
+// by the policy. baseDenom and denom are the base denom of the token and its denom on this chain.
+func (p RoutingPolicy) Check(source, destination PortChannel, baseDenom, denom string) error {
+	if containsPortChannel(p.DeniedChannels, destination) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "next hop channel %s is denied", destination)
+	}
+	if len(p.AllowedChannels) > 0 && !containsPortChannel(p.AllowedChannels, destination) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "next hop channel %s is not allowed", destination)
+	}
+
+	route := ChannelRoute{Source: source, Destination: destination}
+	if containsRoute(p.DeniedRoutes, route) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "route from %s to %s is denied", source, destination)
+	}
+	if len(p.AllowedRoutes) > 0 && !containsRoute(p.AllowedRoutes, route) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "route from %s to %s is not allowed", source, destination)
+	}
+
+	if containsDenom(p.DeniedDenoms, baseDenom, denom) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "denom %s is denied", denom)
+	}
+	if len(p.AllowedDenoms) > 0 && !containsDenom(p.AllowedDenoms, baseDenom, denom) {
+		return errorsmod.Wrapf(ErrForwardNotAllowed, "denom %s is not allowed", denom)
+	}
+
+	return nil
+}
+
+func containsPortChannel(list []PortChannel, pc PortChannel) bool {
+	for _, entry := range list {
+		if entry == pc {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRoute(list []ChannelRoute, route ChannelRoute) bool {
+	for _, entry := range list {
+		if entry == route {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDenom(list []string, baseDenom, denom string) bool {
+	for _, entry := range list {
+		if entry == baseDenom || entry == denom {
+			return true
+		}
+	}
+	return false
+}
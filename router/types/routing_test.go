@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingPolicyCheck(t *testing.T) {
+	var (
+		recv    = types.NewPortChannel("transfer", "channel-0")
+		hopA    = types.NewPortChannel("transfer", "channel-1")
+		hopB    = types.NewPortChannel("transfer", "channel-2")
+		voucher = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	)
+
+	tests := []struct {
+		name     string
+		policy   types.RoutingPolicy
+		next     types.PortChannel
+		denom    string
+		expAllow bool
+	}{
+		{"empty policy", types.RoutingPolicy{}, hopA, "uatom", true},
+		{"denied channel", types.RoutingPolicy{DeniedChannels: []types.PortChannel{hopA}}, hopA, "uatom", false},
+		{"other channel denied", types.RoutingPolicy{DeniedChannels: []types.PortChannel{hopB}}, hopA, "uatom", true},
+		{"allowed channel", types.RoutingPolicy{AllowedChannels: []types.PortChannel{hopA}}, hopA, "uatom", true},
+		{"channel not in allow list", types.RoutingPolicy{AllowedChannels: []types.PortChannel{hopB}}, hopA, "uatom", false},
+		{"denied route", types.RoutingPolicy{DeniedRoutes: []types.ChannelRoute{{Source: recv, Destination: hopA}}}, hopA, "uatom", false},
+		{"route not in allow list", types.RoutingPolicy{AllowedRoutes: []types.ChannelRoute{{Source: recv, Destination: hopB}}}, hopA, "uatom", false},
+		{"denied base denom", types.RoutingPolicy{DeniedDenoms: []string{"uatom"}}, hopA, "uatom", false},
+		{"denied local denom", types.RoutingPolicy{DeniedDenoms: []string{voucher}}, hopA, "uatom", false},
+		{"allowed base denom", types.RoutingPolicy{AllowedDenoms: []string{"uatom"}}, hopA, "uatom", true},
+		{"denom not in allow list", types.RoutingPolicy{AllowedDenoms: []string{"uosmo"}}, hopA, "uatom", false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.policy.Validate())
+			err := tc.policy.Check(recv, tc.next, tc.denom, voucher)
+			if tc.expAllow {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrForwardNotAllowed)
+			}
+		})
+	}
+}
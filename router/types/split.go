@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// SplitForwardMetadata is a next hop of a received transfer fanned out to several next hops. Exactly one of
+// Amount, in units of the received token, or Percentage must be set.
+type SplitForwardMetadata struct {
+	ForwardMetadata
+
+	Amount     string `json:"amount,omitempty"`
+	Percentage string `json:"percentage,omitempty"`
+}
+
+// Validate performs a basic check of the split.
+func (m *SplitForwardMetadata) Validate() error {
+	if err := m.ForwardMetadata.Validate(); err != nil {
+		return err
+	}
+	if (m.Amount == "") == (m.Percentage == "") {
+		return fmt.Errorf("failed to validate split metadata: exactly one of amount or percentage must be set")
+	}
+	if m.Amount != "" {
+		amount, ok := sdk.NewIntFromString(m.Amount)
+		if !ok || !amount.IsPositive() {
+			return fmt.Errorf("failed to validate split metadata: invalid amount %s", m.Amount)
+		}
+		return nil
+	}
+	percentage, err := sdk.NewDecFromStr(m.Percentage)
+	if err != nil {
+		return fmt.Errorf("failed to validate split metadata: invalid percentage %s: %w", m.Percentage, err)
+	}
+	if !percentage.IsPositive() || percentage.GT(sdk.NewDec(100)) {
+		return fmt.Errorf("failed to validate split metadata: percentage must be in (0, 100]: %s", m.Percentage)
+	}
+	return nil
+}
+
+// ParseSplitAckPolicy parses the split_ack_policy of the forward metadata.
+func ParseSplitAckPolicy(policy string) (SplitAckPolicy, error) {
+	switch policy {
+	case "", "all_or_nothing":
+		return SplitAckPolicyAllOrNothing, nil
+	case "partial":
+		return SplitAckPolicyPartial, nil
+	default:
+		return SplitAckPolicyAllOrNothing, fmt.Errorf("invalid split ack policy: %s", policy)
+	}
+}
+
+// SplitAmounts returns the amount of the received transfer forwarded by each split. Splits with an absolute
+// amount are allocated first and splits with a percentage share the remainder, so their percentages must add up
+// to 100. Rounding dust is added to the last split with a percentage. Without percentages, the absolute amounts
+// must add up to the received amount.
+func SplitAmounts(amount sdk.Int, splits []*SplitForwardMetadata) ([]sdk.Int, error) {
+	amounts := make([]sdk.Int, len(splits))
+	remainder := amount
+	totalPercentage := sdk.ZeroDec()
+	lastPercentage := -1
+
+	for i, split := range splits {
+		if split.Amount == "" {
+			percentage, err := sdk.NewDecFromStr(split.Percentage)
+			if err != nil {
+				return nil, err
+			}
+			totalPercentage = totalPercentage.Add(percentage)
+			lastPercentage = i
+			continue
+		}
+		splitAmount, ok := sdk.NewIntFromString(split.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid split amount: %s", split.Amount)
+		}
+		amounts[i] = splitAmount
+		remainder = remainder.Sub(splitAmount)
+	}
+
+	if remainder.IsNegative() {
+		return nil, fmt.Errorf("split amounts add up to more than the received amount %s", amount)
+	}
+
+	if lastPercentage < 0 {
+		if !remainder.IsZero() {
+			return nil, fmt.Errorf("split amounts must add up to the received amount %s", amount)
+		}
+		return amounts, nil
+	}
+
+	if !totalPercentage.Equal(sdk.NewDec(100)) {
+		return nil, fmt.Errorf("split percentages must add up to 100, got %s", totalPercentage)
+	}
+
+	allocated := sdk.ZeroInt()
+	for i, split := range splits {
+		if split.Amount != "" {
+			continue
+		}
+		percentage := sdk.MustNewDecFromStr(split.Percentage)
+		amounts[i] = percentage.MulInt(remainder).QuoInt64(100).TruncateInt()
+		allocated = allocated.Add(amounts[i])
+	}
+	amounts[lastPercentage] = amounts[lastPercentage].Add(remainder.Sub(allocated))
+
+	for i, splitAmount := range amounts {
+		if !splitAmount.IsPositive() {
+			return nil, fmt.Errorf("split %d of the received amount %s is empty", i, amount)
+		}
+	}
+
+	return amounts, nil
+}
+
+// SplitEscrowAddress returns the address holding the funds of failed splits while the received packet may still
+// be refunded.
+func SplitEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("split"))
+}
+
+// NewSplitForward returns the tracking state of a received packet fanned out to pending splits.
+func NewSplitForward(policy SplitAckPolicy, receiver string, pending uint32) SplitForward {
+	return SplitForward{
+		AckPolicy: policy,
+		Receiver:  receiver,
+		Pending:   pending,
+	}
+}
+
+// Refundable returns true if the received packet can still be refunded with an error acknowledgement, which is
+// the case under the all or nothing policy until a split succeeds or is recovered.
+func (s SplitForward) Refundable(nonrefundable bool) bool {
+	return s.AckPolicy == SplitAckPolicyAllOrNothing && s.Succeeded == 0 && s.Recovered == 0 && !nonrefundable
+}
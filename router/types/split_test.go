@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/strangelove-ventures/packet-forward-middleware/v7/router/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAmounts(t *testing.T) {
+	amount := func(a string) *types.SplitForwardMetadata { return &types.SplitForwardMetadata{Amount: a} }
+	percentage := func(p string) *types.SplitForwardMetadata { return &types.SplitForwardMetadata{Percentage: p} }
+
+	tests := []struct {
+		name       string
+		splits     []*types.SplitForwardMetadata
+		expAmounts []int64
+		expErr     bool
+	}{
+		{"absolute amounts", []*types.SplitForwardMetadata{amount("30"), amount("70")}, []int64{30, 70}, false},
+		{"absolute amounts below received amount", []*types.SplitForwardMetadata{amount("30"), amount("60")}, nil, true},
+		{"absolute amounts above received amount", []*types.SplitForwardMetadata{amount("30"), amount("80")}, nil, true},
+		{"percentages", []*types.SplitForwardMetadata{percentage("25"), percentage("75")}, []int64{25, 75}, false},
+		{"percentages with dust", []*types.SplitForwardMetadata{percentage("33.3"), percentage("33.3"), percentage("33.4")}, []int64{33, 33, 34}, false},
+		{"percentages of remainder", []*types.SplitForwardMetadata{amount("50"), percentage("50"), percentage("50")}, []int64{50, 25, 25}, false},
+		{"percentages below 100", []*types.SplitForwardMetadata{percentage("50"), percentage("40")}, nil, true},
+		{"empty split", []*types.SplitForwardMetadata{amount("100"), percentage("100")}, nil, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amounts, err := types.SplitAmounts(sdk.NewInt(100), tc.splits)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, amounts, len(tc.expAmounts))
+			for i, expAmount := range tc.expAmounts {
+				require.Equal(t, sdk.NewInt(expAmount), amounts[i])
+			}
+		})
+	}
+}
+
+func TestPacketMetadataForwards(t *testing.T) {
+	forward := types.ForwardMetadata{Receiver: "receiver", Port: "transfer", Channel: "channel-0"}
+
+	tests := []struct {
+		name     string
+		metadata types.PacketMetadata
+		expLen   int
+		expErr   bool
+	}{
+		{"forward", types.PacketMetadata{Forward: &forward}, 1, false},
+		{"splits", types.PacketMetadata{Splits: []*types.SplitForwardMetadata{
+			{ForwardMetadata: forward, Amount: "10"}, {ForwardMetadata: forward, Percentage: "100"},
+		}}, 2, false},
+		{"forward and splits", types.PacketMetadata{Forward: &forward, Splits: []*types.SplitForwardMetadata{
+			{ForwardMetadata: forward, Amount: "10"},
+		}}, 0, true},
+		{"no forward", types.PacketMetadata{}, 0, true},
+		{"invalid recover address", types.PacketMetadata{Forward: &types.ForwardMetadata{
+			Receiver: "receiver", Port: "transfer", Channel: "channel-0", RecoverAddress: "recover",
+		}}, 0, true},
+		{"split without amount", types.PacketMetadata{Splits: []*types.SplitForwardMetadata{{ForwardMetadata: forward}}}, 0, true},
+		{"split with amount and percentage", types.PacketMetadata{Splits: []*types.SplitForwardMetadata{
+			{ForwardMetadata: forward, Amount: "10", Percentage: "100"},
+		}}, 0, true},
+		{"split with invalid percentage", types.PacketMetadata{Splits: []*types.SplitForwardMetadata{
+			{ForwardMetadata: forward, Percentage: "101"},
+		}}, 0, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			forwards, err := tc.metadata.Forwards()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, forwards, tc.expLen)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/strangelove-ventures/packet-forward-middleware/v7/router/types (interfaces: ClientKeeper)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	exported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockClientKeeper is a mock of ClientKeeper interface.
+type MockClientKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientKeeperMockRecorder
+}
+
+// MockClientKeeperMockRecorder is the mock recorder for MockClientKeeper.
+type MockClientKeeperMockRecorder struct {
+	mock *MockClientKeeper
+}
+
+// NewMockClientKeeper creates a new mock instance.
+func NewMockClientKeeper(ctrl *gomock.Controller) *MockClientKeeper {
+	mock := &MockClientKeeper{ctrl: ctrl}
+	mock.recorder = &MockClientKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientKeeper) EXPECT() *MockClientKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetClientConsensusState mocks base method.
+func (m *MockClientKeeper) GetClientConsensusState(arg0 types.Context, arg1 string, arg2 exported.Height) (exported.ConsensusState, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientConsensusState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(exported.ConsensusState)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetClientConsensusState indicates an expected call of GetClientConsensusState.
+func (mr *MockClientKeeperMockRecorder) GetClientConsensusState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientConsensusState", reflect.TypeOf((*MockClientKeeper)(nil).GetClientConsensusState), arg0, arg1, arg2)
+}
+
+// GetClientStatus mocks base method.
+func (m *MockClientKeeper) GetClientStatus(arg0 types.Context, arg1 exported.ClientState, arg2 string) exported.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(exported.Status)
+	return ret0
+}
+
+// GetClientStatus indicates an expected call of GetClientStatus.
+func (mr *MockClientKeeperMockRecorder) GetClientStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientStatus", reflect.TypeOf((*MockClientKeeper)(nil).GetClientStatus), arg0, arg1, arg2)
+}
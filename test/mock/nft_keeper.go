@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/strangelove-ventures/packet-forward-middleware/v7/router/types (interfaces: NFTKeeper)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNFTKeeper is a mock of NFTKeeper interface.
+type MockNFTKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockNFTKeeperMockRecorder
+}
+
+// MockNFTKeeperMockRecorder is the mock recorder for MockNFTKeeper.
+type MockNFTKeeperMockRecorder struct {
+	mock *MockNFTKeeper
+}
+
+// NewMockNFTKeeper creates a new mock instance.
+func NewMockNFTKeeper(ctrl *gomock.Controller) *MockNFTKeeper {
+	mock := &MockNFTKeeper{ctrl: ctrl}
+	mock.recorder = &MockNFTKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNFTKeeper) EXPECT() *MockNFTKeeperMockRecorder {
+	return m.recorder
+}
+
+// Burn mocks base method.
+func (m *MockNFTKeeper) Burn(arg0 types.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Burn", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Burn indicates an expected call of Burn.
+func (mr *MockNFTKeeperMockRecorder) Burn(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockNFTKeeper)(nil).Burn), arg0, arg1, arg2)
+}
+
+// Transfer mocks base method.
+func (m *MockNFTKeeper) Transfer(arg0 types.Context, arg1, arg2 string, arg3 types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockNFTKeeperMockRecorder) Transfer(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockNFTKeeper)(nil).Transfer), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/strangelove-ventures/packet-forward-middleware/v7/router/types (interfaces: NFTTransferKeeper)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+	types0 "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNFTTransferKeeper is a mock of NFTTransferKeeper interface.
+type MockNFTTransferKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockNFTTransferKeeperMockRecorder
+}
+
+// MockNFTTransferKeeperMockRecorder is the mock recorder for MockNFTTransferKeeper.
+type MockNFTTransferKeeperMockRecorder struct {
+	mock *MockNFTTransferKeeper
+}
+
+// NewMockNFTTransferKeeper creates a new mock instance.
+func NewMockNFTTransferKeeper(ctrl *gomock.Controller) *MockNFTTransferKeeper {
+	mock := &MockNFTTransferKeeper{ctrl: ctrl}
+	mock.recorder = &MockNFTTransferKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNFTTransferKeeper) EXPECT() *MockNFTTransferKeeperMockRecorder {
+	return m.recorder
+}
+
+// ClassPathFromHash mocks base method.
+func (m *MockNFTTransferKeeper) ClassPathFromHash(arg0 types.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClassPathFromHash", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClassPathFromHash indicates an expected call of ClassPathFromHash.
+func (mr *MockNFTTransferKeeperMockRecorder) ClassPathFromHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClassPathFromHash", reflect.TypeOf((*MockNFTTransferKeeper)(nil).ClassPathFromHash), arg0, arg1)
+}
+
+// GetEscrowAddress mocks base method.
+func (m *MockNFTTransferKeeper) GetEscrowAddress(arg0, arg1 string) types.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrowAddress", arg0, arg1)
+	ret0, _ := ret[0].(types.AccAddress)
+	return ret0
+}
+
+// GetEscrowAddress indicates an expected call of GetEscrowAddress.
+func (mr *MockNFTTransferKeeperMockRecorder) GetEscrowAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowAddress", reflect.TypeOf((*MockNFTTransferKeeper)(nil).GetEscrowAddress), arg0, arg1)
+}
+
+// SendTransfer mocks base method.
+func (m *MockNFTTransferKeeper) SendTransfer(arg0 types.Context, arg1, arg2, arg3 string, arg4 []string, arg5 types.AccAddress, arg6 string, arg7 types0.Height, arg8 uint64, arg9 string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransfer", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransfer indicates an expected call of SendTransfer.
+func (mr *MockNFTTransferKeeperMockRecorder) SendTransfer(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransfer", reflect.TypeOf((*MockNFTTransferKeeper)(nil).SendTransfer), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+}
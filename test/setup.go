@@ -12,8 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	"github.com/golang/mock/gomock"
 	"github.com/strangelove-ventures/packet-forward-middleware/v7/router"
@@ -29,35 +29,44 @@ func NewTestSetup(t *testing.T, ctl *gomock.Controller) *Setup {
 
 	transferKeeperMock := mock.NewMockTransferKeeper(ctl)
 	channelKeeperMock := mock.NewMockChannelKeeper(ctl)
+	clientKeeperMock := mock.NewMockClientKeeper(ctl)
 	distributionKeeperMock := mock.NewMockDistributionKeeper(ctl)
+	accountKeeperMock := mock.NewMockAccountKeeper(ctl)
 	bankKeeperMock := mock.NewMockBankKeeper(ctl)
 	ibcModuleMock := mock.NewMockIBCModule(ctl)
 	ics4WrapperMock := mock.NewMockICS4Wrapper(ctl)
+	nftTransferKeeperMock := mock.NewMockNFTTransferKeeper(ctl)
+	nftKeeperMock := mock.NewMockNFTKeeper(ctl)
 
-	paramsKeeper := initializer.paramsKeeper()
-	routerKeeper := initializer.routerKeeper(paramsKeeper, transferKeeperMock, channelKeeperMock, distributionKeeperMock, bankKeeperMock, ics4WrapperMock)
+	routerKeeper := initializer.routerKeeper(transferKeeperMock, channelKeeperMock, clientKeeperMock, distributionKeeperMock, accountKeeperMock, bankKeeperMock, ics4WrapperMock)
 	// routerModule := initializer.routerModule(routerKeeper)
 
 	require.NoError(t, initializer.StateStore.LoadLatestVersion())
 
-	routerKeeper.SetParams(initializer.Ctx, types.DefaultParams())
+	routerKeeper.SetNFTKeepers(nftTransferKeeperMock, nftKeeperMock)
+	require.NoError(t, routerKeeper.SetParams(initializer.Ctx, types.DefaultParams()))
 
 	return &Setup{
 		Initializer: initializer,
 
 		Keepers: &testKeepers{
-			ParamsKeeper: &paramsKeeper,
 			RouterKeeper: routerKeeper,
 		},
 
 		Mocks: &testMocks{
 			TransferKeeperMock:     transferKeeperMock,
+			ChannelKeeperMock:      channelKeeperMock,
+			ClientKeeperMock:       clientKeeperMock,
 			DistributionKeeperMock: distributionKeeperMock,
+			AccountKeeperMock:      accountKeeperMock,
+			BankKeeperMock:         bankKeeperMock,
 			IBCModuleMock:          ibcModuleMock,
 			ICS4WrapperMock:        ics4WrapperMock,
+			NFTTransferKeeperMock:  nftTransferKeeperMock,
+			NFTKeeperMock:          nftKeeperMock,
 		},
 
-		ForwardMiddleware: initializer.forwardMiddleware(ibcModuleMock, routerKeeper, 0, keeper.DefaultForwardTransferPacketTimeoutTimestamp, keeper.DefaultRefundTransferPacketTimeoutTimestamp),
+		ForwardMiddleware: initializer.forwardMiddleware(ibcModuleMock, routerKeeper, 0, keeper.DefaultForwardTransferPacketTimeoutTimestamp, keeper.DefaultRefundTransferPacketTimeoutTimestamp, 0),
 	}
 }
 
@@ -71,15 +80,20 @@ type Setup struct {
 }
 
 type testKeepers struct {
-	ParamsKeeper *paramskeeper.Keeper
 	RouterKeeper *keeper.Keeper
 }
 
 type testMocks struct {
 	TransferKeeperMock     *mock.MockTransferKeeper
+	ChannelKeeperMock      *mock.MockChannelKeeper
+	ClientKeeperMock       *mock.MockClientKeeper
 	DistributionKeeperMock *mock.MockDistributionKeeper
+	AccountKeeperMock      *mock.MockAccountKeeper
+	BankKeeperMock         *mock.MockBankKeeper
 	IBCModuleMock          *mock.MockIBCModule
 	ICS4WrapperMock        *mock.MockICS4Wrapper
+	NFTTransferKeeperMock  *mock.MockNFTTransferKeeper
+	NFTKeeperMock          *mock.MockNFTKeeper
 }
 
 type initializer struct {
@@ -112,43 +126,41 @@ func newInitializer() initializer {
 	}
 }
 
-func (i initializer) paramsKeeper() paramskeeper.Keeper {
-	storeKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
-	transientStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
-	i.StateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, i.DB)
-	i.StateStore.MountStoreWithDB(transientStoreKey, storetypes.StoreTypeTransient, i.DB)
-
-	paramsKeeper := paramskeeper.NewKeeper(i.Marshaler, i.Amino, storeKey, transientStoreKey)
-
-	return paramsKeeper
-}
-
 func (i initializer) routerKeeper(
-	paramsKeeper paramskeeper.Keeper,
 	transferKeeper types.TransferKeeper,
 	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
 	distributionKeeper types.DistributionKeeper,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	ics4Wrapper porttypes.ICS4Wrapper,
 ) *keeper.Keeper {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	i.StateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, i.DB)
 
-	subspace := paramsKeeper.Subspace(types.ModuleName)
 	routerKeeper := keeper.NewKeeper(
 		i.Marshaler,
 		storeKey,
-		subspace,
 		transferKeeper,
 		channelKeeper,
+		clientKeeper,
 		distributionKeeper,
+		accountKeeper,
 		bankKeeper,
 		ics4Wrapper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return routerKeeper
 }
 
-func (i initializer) forwardMiddleware(app porttypes.IBCModule, k *keeper.Keeper, retriesOnTimeout uint8, forwardTimeout time.Duration, refundTimeout time.Duration) router.IBCMiddleware {
-	return router.NewIBCMiddleware(app, k, retriesOnTimeout, forwardTimeout, refundTimeout)
+func (i initializer) forwardMiddleware(
+	app porttypes.IBCModule,
+	k *keeper.Keeper,
+	retriesOnTimeout uint8,
+	forwardTimeout time.Duration,
+	refundTimeout time.Duration,
+	forwardTimeoutHeightOffset uint64,
+) router.IBCMiddleware {
+	return router.NewIBCMiddleware(app, k, retriesOnTimeout, forwardTimeout, refundTimeout, forwardTimeoutHeightOffset)
 }